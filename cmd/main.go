@@ -1,15 +1,32 @@
 package main
 
 import (
+	"errors"
 	log "log/slog"
 	"os"
 
 	cli "github.com/kyma-project/gardener-syncer/internal"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+)
+
+const (
+	// exitCodeDrift is returned in dry-run mode if the stored seeds would change.
+	exitCodeDrift = 2
+	// exitCodeCanceled is returned if the sync is canceled by a signal.
+	exitCodeCanceled = 3
 )
 
 func main() {
 	if err := cli.Run(); err != nil {
+		if errors.Is(err, seeker.ErrCanceled) {
+			log.Warn(err.Error())
+			os.Exit(exitCodeCanceled)
+		}
+
 		log.Error(err.Error())
+		if errors.Is(err, seeker.ErrDriftDetected) {
+			os.Exit(exitCodeDrift)
+		}
 		os.Exit(1)
 	}
 }
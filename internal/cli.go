@@ -1,15 +1,25 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/gardener-syncer/internal/k8s/client"
 	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/apis/v1alpha1"
+	"github.com/kyma-project/gardener-syncer/pkg/metrics"
+	"github.com/kyma-project/gardener-syncer/pkg/server"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var defaultKcpClientTimeout = time.Second * 10
@@ -19,47 +29,354 @@ func Run() error {
 	if err != nil {
 		return err
 	}
-	slog.Info("application started")
+	slog.Info("application started", "mode", cfg.Mode)
 
-	kcpClient, err := client.New(client.Options{
-		AdditionalAddToSchema: []func(*runtime.Scheme) error{
-			corev1.AddToScheme,
-		},
-	})
+	// the signals cancel the in-flight requests of the sync
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
+	p, err := cfg.buildTargets()
 	if err != nil {
 		return err
 	}
 
-	store := seeker.BuildStoreFn(seeker.StoreOpts{
-		Key:     cfg.seedMapKey(),
-		Patch:   kcpClient.Patch,
-		Get:     kcpClient.Get,
-		Convert: seeker.ToConfigMap,
-		Timeout: defaultKcpClientTimeout,
-	})
+	if p.evaluate, err = cfg.evaluator(); err != nil {
+		return err
+	}
+	p.detailed = cfg.detailed()
+	p.runTimeout = mustParseDuration(cfg.RunTimeout)
 
-	gardenerClient, err := client.New(
-		client.Options{
-			KubeconfigPath: cfg.Gardener.KubeconfigPath,
-			AdditionalAddToSchema: []func(*runtime.Scheme) error{
-				v1beta1.AddToScheme,
-			},
-		},
-	)
+	if cfg.Mode == ModeController {
+		return runController(ctx, cfg, p)
+	}
+
+	var gardens []seeker.FetchSeedsOpts
+	for _, garden := range cfg.gardens() {
+		gardenerClient, err := client.New(garden.clientOptions())
+		if err != nil {
+			return err
+		}
+
+		opts, err := garden.fetchSeedsOpts(seeker.RetryList(gardenerClient.List, cfg.retryOpts()))
+		if err != nil {
+			return err
+		}
+		gardens = append(gardens, opts)
+	}
+
+	if cfg.Mode == ModeDaemon {
+		var state *server.State
+		if cfg.API.BindAddress != apiDisabled {
+			state = server.NewState(mustParseDuration(cfg.API.Staleness))
+			p.store = state.InstrumentStore(p.store)
+		}
+		return runDaemon(ctx, cfg, p.buildSync(gardens), state, p.followed)
+	}
+
+	return p.buildSync(gardens)(ctx)
+}
+
+// pipeline holds the parts of the sync shared by all the run modes.
+type pipeline struct {
+	store          seeker.Store
+	report         seeker.Report
+	failureReports []seeker.FailureReport
+	// fetchFailureReports are called only if the seeds can not be fetched.
+	fetchFailureReports []seeker.FailureReport
+	// record is optional, it records the events of all the targets.
+	record   seeker.Recorder
+	evaluate seeker.Evaluate
+	detailed bool
+	// runTimeout is the deadline of every sync, 0 disables it.
+	runTimeout time.Duration
+	// followed is the sink of the first target, read by the replicas that
+	// are not leading.
+	followed seeker.Sink
+}
+
+func (p pipeline) buildSync(gardens []seeker.FetchSeedsOpts) seeker.Sync {
+	for i := range gardens {
+		gardens[i].Detailed = p.detailed
+		gardens[i].Evaluate = p.evaluate
+		gardens[i].Record = p.record
+	}
+
+	var fetch seeker.FetchSeeds
+	if len(gardens) == 1 {
+		opts := gardens[0]
+		opts.Report = p.report
+		fetch = seeker.BuildFetchSeedFn(opts)
+	} else {
+		fetch = seeker.BuildMultiGardenFetchFn(seeker.MultiGardenFetchOpts{
+			Gardens: gardens,
+			Report:  p.report,
+		})
+	}
+
+	fetch = metrics.InstrumentFetch(fetch)
+	for _, report := range p.fetchFailureReports {
+		fetch = seeker.ReportFetchFailures(fetch, report)
+	}
+
+	sync := seeker.BuildSyncFn(p.store, fetch)
+	for _, report := range p.failureReports {
+		sync = seeker.ReportFailures(sync, report)
+	}
+	return metrics.InstrumentSync(seeker.WithRunTimeout(sync, p.runTimeout))
+}
+
+// buildTargets builds the parts of the pipeline writing to the targets: the
+// store writing the seeds cache to all of them and the reports of the
+// verdicts and failures. The targets sharing a kubeconfig share the client,
+// the targets writing to a file need no client at all.
+func (c *Config) buildTargets() (pipeline, error) {
+	clients := map[string]ctrlclient.Client{}
+	kcpClient := func(target TargetConfig) (ctrlclient.Client, error) {
+		if kcpClient, found := clients[target.KubeconfigPath]; found {
+			return kcpClient, nil
+		}
+
+		kcpClient, err := client.New(target.clientOptions())
+		if err != nil {
+			return nil, err
+		}
+		clients[target.KubeconfigPath] = kcpClient
+		return kcpClient, nil
+	}
+
+	var p pipeline
+	var stores []seeker.TargetStore
+	var recorders []seeker.Recorder
+	reports := []seeker.Report{metrics.RecordVerdicts}
+	for _, target := range c.targets() {
+		sink, err := target.sink(kcpClient, c.retryOpts())
+		if err != nil {
+			return pipeline{}, err
+		}
+		if p.followed == nil {
+			p.followed = sink
+		}
+
+		// the catalog reports the failed syncs in its status conditions, the
+		// other sinks mark the stored data as stale
+		if catalog, ok := sink.(seeker.CatalogSink); ok && !c.DryRun {
+			p.failureReports = append(p.failureReports, catalog.ReportFailure)
+		} else if !c.DryRun {
+			p.fetchFailureReports = append(p.fetchFailureReports, seeker.BuildMarkStaleFn(seeker.MarkStaleOpts{
+				Sink:    sink,
+				Timeout: defaultKcpClientTimeout,
+			}))
+		}
+
+		// the events are recorded against the object the seeds cache is
+		// stored in, so there are none for the files
+		var recorder seeker.Recorder
+		if objectSink, ok := sink.(seeker.ObjectSink); ok && c.Events && !c.DryRun {
+			eventClient, err := kcpClient(target)
+			if err != nil {
+				return pipeline{}, err
+			}
+			recorder = seeker.BuildEventRecorderFn(seeker.EventRecorderOpts{
+				ObjectSink: objectSink,
+				Create:     eventClient.Create,
+				Scheme:     eventClient.Scheme(),
+				Timeout:    defaultKcpClientTimeout,
+			})
+			recorders = append(recorders, recorder)
+		}
+
+		stores = append(stores, seeker.TargetStore{
+			Target: target.Name,
+			Store: seeker.BuildStoreFn(seeker.StoreOpts{
+				Sink:    sink,
+				Convert: seeker.ToConfigMapFn(types.SchemaVersion(c.OutputSchema)),
+				Timeout: defaultKcpClientTimeout,
+				Guard: seeker.BuildShrinkGuardFn(seeker.ShrinkGuardOpts{
+					MaxShrinkPercent: c.Store.MaxShrinkPercent,
+					AllowShrink:      c.Store.AllowShrink,
+				}),
+				DryRun:               c.DryRun,
+				Out:                  os.Stdout,
+				RefreshLastSync:      c.Store.RefreshLastSync,
+				FetchRefreshInterval: mustParseDuration(c.Store.FetchRefreshInterval),
+				Record:               recorder,
+			}),
+		})
+
+		// The verdicts are not stored in dry-run, only the seeds cache is
+		// compared.
+		if target.VerdictMapName != "" && !c.DryRun {
+			verdictClient, err := kcpClient(target)
+			if err != nil {
+				return pipeline{}, err
+			}
+
+			reports = append(reports, seeker.ReportTo(seeker.BuildVerdictStoreFn(seeker.VerdictStoreOpts{
+				Key:     target.verdictMapKey(),
+				Patch:   seeker.RetryPatch(verdictClient.Patch, c.retryOpts()),
+				Timeout: defaultKcpClientTimeout,
+			})))
+		}
+	}
+
+	store := stores[0].Store
+	if len(stores) > 1 {
+		store = seeker.BuildMultiTargetStoreFn(stores)
+	}
+
+	if len(recorders) > 0 {
+		p.record = seeker.Recorders(recorders...)
+	}
+
+	p.store = metrics.InstrumentStore(store)
+	p.report = seeker.Reports(reports...)
+	return p, nil
+}
+
+// sink returns the sink the target is written to, the client is created
+// only for the sinks in a cluster. The requests of the client are retried.
+func (t TargetConfig) sink(kcpClient func(TargetConfig) (ctrlclient.Client, error), retry seeker.RetryOpts) (seeker.Sink, error) {
+	if t.Sink == SinkFile {
+		return seeker.FileSink{
+			Path:   t.FilePath,
+			Format: seeker.FileFormat(t.FileFormat),
+		}, nil
+	}
 
+	c, err := kcpClient(t)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	get := seeker.RetryGet(c.Get, retry)
+	patch := seeker.RetryPatch(c.Patch, retry)
+
+	switch t.Sink {
+	case SinkSecret:
+		return seeker.SecretSink{Key: t.seedMapKey(), Get: get, Patch: patch}, nil
+	case SinkCatalog:
+		return seeker.CatalogSink{
+			Name:        t.SeedMapName,
+			Timeout:     defaultKcpClientTimeout,
+			Get:         get,
+			Patch:       patch,
+			PatchStatus: c.Status().Patch,
+		}, nil
+	case SinkCustomResource:
+		gv, err := schema.ParseGroupVersion(t.ResourceAPIVersion)
+		if err != nil {
+			return nil, err
+		}
+		return seeker.CustomResourceSink{Key: t.seedMapKey(), GVK: gv.WithKind(t.ResourceKind), Get: get, Patch: patch}, nil
+	default:
+		return seeker.ConfigMapSink{Key: t.seedMapKey(), Get: get, Patch: patch}, nil
 	}
+}
+
+func (c *Config) evaluator() (seeker.Evaluate, error) {
+	policy := seeker.DefaultPolicy
+	if c.PolicyPath != "" {
+		var err error
+		if policy, err = seeker.LoadPolicy(c.PolicyPath); err != nil {
+			return nil, err
+		}
+		slog.Info("policy loaded", "path", c.PolicyPath)
+	}
+
+	return policy.Tolerate(c.toleratedTaints()...).Evaluator()
+}
 
-	gardenerTimeout := mustParseDuration(cfg.Gardener.Timeout)
-	fetch := seeker.BuildFetchSeedFn(seeker.FetchSeedsOpts{
-		List:    gardenerClient.List,
-		Timeout: gardenerTimeout,
+// runDaemon runs the sync periodically until the process is terminated, the
+// read API serves the state if it is not nil. The state of a replica that is
+// not leading is read from the followed sink.
+func runDaemon(ctx context.Context, cfg Config, sync seeker.Sync, state *server.State, followed seeker.Sink) error {
+	ctx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	if cfg.Metrics.BindAddress != metricsDisabled {
+		go func() {
+			if err := metrics.Serve(ctx, cfg.Metrics.BindAddress); err != nil {
+				slog.Error(err.Error())
+				stop()
+			}
+		}()
+	}
+
+	if state != nil {
+		go func() {
+			if err := server.Serve(ctx, cfg.API.BindAddress, state.Handler()); err != nil {
+				slog.Error(err.Error())
+				stop()
+			}
+		}()
+	}
+
+	loop := seeker.BuildLoopFn(seeker.LoopOpts{
+		Interval:               mustParseDuration(cfg.Daemon.SyncInterval),
+		JitterFactor:           cfg.Daemon.JitterFactor,
+		InitialBackoff:         mustParseDuration(cfg.Daemon.InitialBackoff),
+		MaxBackoff:             mustParseDuration(cfg.Daemon.MaxBackoff),
+		MaxConsecutiveFailures: cfg.Daemon.MaxConsecutiveFailures,
+		Sync:                   sync,
 	})
 
-	sync := seeker.BuildSyncFn(store, fetch)
-	return sync()
+	if !cfg.LeaderElection.Enabled {
+		slog.Info("starting daemon")
+		return loop(ctx)
+	}
+
+	leading := func(bool) {}
+	if state != nil {
+		leading = state.SetLeading
+		state.SetLeading(false)
+		go state.Follow(ctx, followed, mustParseDuration(cfg.Daemon.SyncInterval), defaultKcpClientTimeout)
+	}
+
+	slog.Info("starting daemon with leader election")
+	return runElected(ctx, cfg, loop, leading)
+}
+
+func (c *Config) kcpClientOptions() client.Options {
+	return client.Options{
+		KubeconfigPath: c.KCP.KubeconfigPath,
+		AdditionalAddToSchema: []func(*runtime.Scheme) error{
+			corev1.AddToScheme,
+			v1alpha1.AddToScheme,
+		},
+	}
+}
+
+func (t TargetConfig) clientOptions() client.Options {
+	return client.Options{
+		KubeconfigPath: t.KubeconfigPath,
+		AdditionalAddToSchema: []func(*runtime.Scheme) error{
+			corev1.AddToScheme,
+			v1alpha1.AddToScheme,
+		},
+	}
+}
+
+func (g GardenConfig) clientOptions() client.Options {
+	return client.Options{
+		KubeconfigPath: g.KubeconfigPath,
+		AdditionalAddToSchema: []func(*runtime.Scheme) error{
+			v1beta1.AddToScheme,
+		},
+	}
+}
+
+func (g GardenConfig) fetchSeedsOpts(list seeker.List) (seeker.FetchSeedsOpts, error) {
+	selectors, err := seeker.ParseSelectors(g.LabelSelector, g.FieldSelector)
+	if err != nil {
+		return seeker.FetchSeedsOpts{}, err
+	}
+
+	return seeker.FetchSeedsOpts{
+		Garden:    g.Name,
+		List:      list,
+		Selectors: selectors,
+		PageSize:  int64(*g.PageSize),
+		Timeout:   mustParseDuration(g.Timeout),
+	}, nil
 }
 
 func mustParseDuration(s string) time.Duration {
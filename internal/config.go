@@ -4,9 +4,14 @@ import (
 	"flag"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
-	"sigs.k8s.io/controller-runtime/pkg/client"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 type Gardener struct {
@@ -14,17 +19,139 @@ type Gardener struct {
 	Timeout          string
 	SeedMapName      string
 	SeedMapNamespace string
+	VerdictMapName   string
+	LabelSelector    string
+	FieldSelector    string
+	PageSize         int
+}
+
+type Controller struct {
+	Debounce string
+}
+
+type Daemon struct {
+	SyncInterval           string
+	JitterFactor           float64
+	InitialBackoff         string
+	MaxBackoff             string
+	MaxConsecutiveFailures int
+}
+
+type Store struct {
+	MaxShrinkPercent     float64
+	AllowShrink          bool
+	RefreshLastSync      bool
+	FetchRefreshInterval string
+}
+
+type Output struct {
+	Sink               string
+	FilePath           string
+	FileFormat         string
+	ResourceAPIVersion string
+	ResourceKind       string
+}
+
+type KCP struct {
+	KubeconfigPath string
+}
+
+type Metrics struct {
+	BindAddress string
+}
+
+type LeaderElection struct {
+	Enabled       bool
+	ID            string
+	Namespace     string
+	LeaseDuration string
+	RenewDeadline string
+	RetryPeriod   string
+}
+
+type Retry struct {
+	Attempts       int
+	InitialBackoff string
+	MaxBackoff     string
+}
+
+type API struct {
+	BindAddress string
+	Staleness   string
 }
 
 type Config struct {
-	Gardener Gardener
+	ConfigPath   string
+	File         FileConfig
+	Mode         string
+	DryRun       bool
+	Events       bool
+	OutputSchema string
+	PolicyPath   string
+	RunTimeout   string
+	// ToleratedTaints is a comma separated list of taint keys.
+	ToleratedTaints string
+	KCP             KCP
+	Output          Output
+	Store           Store
+	Gardener        Gardener
+	Controller      Controller
+	Daemon          Daemon
+	Metrics         Metrics
+	API             API
+	LeaderElection  LeaderElection
+	Retry           Retry
 }
 
-func (c *Config) seedMapKey() client.ObjectKey {
-	return client.ObjectKey{
-		Namespace: c.Gardener.SeedMapNamespace,
-		Name:      c.Gardener.SeedMapName,
+const (
+	ModeOneShot    = "oneshot"
+	ModeDaemon     = "daemon"
+	ModeController = "controller"
+)
+
+var modes = []string{ModeOneShot, ModeDaemon, ModeController}
+
+const (
+	SinkConfigMap      = "configmap"
+	SinkSecret         = "secret"
+	SinkFile           = "file"
+	SinkCustomResource = "customresource"
+	SinkCatalog        = "catalog"
+)
+
+var sinks = []string{SinkConfigMap, SinkSecret, SinkFile, SinkCustomResource, SinkCatalog}
+
+// metricsDisabled is the metrics bind address that turns the metrics endpoint off.
+const metricsDisabled = "0"
+
+// apiDisabled is the API bind address that turns the read API off.
+const apiDisabled = "0"
+
+// detailed tells if the output schema holds the seed details.
+func (c *Config) detailed() bool {
+	return types.SchemaVersion(c.OutputSchema) != types.SchemaV1
+}
+
+func (c *Config) retryOpts() seeker.RetryOpts {
+	return seeker.RetryOpts{
+		Attempts:       c.Retry.Attempts,
+		InitialBackoff: mustParseDuration(c.Retry.InitialBackoff),
+		MaxBackoff:     mustParseDuration(c.Retry.MaxBackoff),
+	}
+}
+
+func (c *Config) toleratedTaints() []string {
+	return splitList(c.ToleratedTaints)
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
 	}
+	return out
 }
 
 var ErrInvalidValue = fmt.Errorf("invalid value")
@@ -47,6 +174,44 @@ func isValidDuration(s string) bool {
 	return err == nil
 }
 
+func isPositiveDuration(s string) bool {
+	d, err := time.ParseDuration(s)
+	return err == nil && d > 0
+}
+
+func isNotNegativeDuration(s string) bool {
+	d, err := time.ParseDuration(s)
+	return err == nil && d >= 0
+}
+
+func isNotNegative[T int | float64](v T) bool {
+	return v >= 0
+}
+
+func isPercent(v float64) bool {
+	return v >= 0 && v <= 100
+}
+
+func isValidSchemaVersion(s string) bool {
+	return types.SchemaVersion(s).Validate() == nil
+}
+
+func isValidLabelSelector(s string) bool {
+	_, err := labels.Parse(s)
+	return err == nil
+}
+
+func isValidFieldSelector(s string) bool {
+	_, err := fields.ParseSelector(s)
+	return err == nil
+}
+
+func isOneOf(values ...string) func(string) bool {
+	return func(s string) bool {
+		return slices.Contains(values, s)
+	}
+}
+
 func (c *Config) Validate() error {
 	for _, item := range []struct {
 		fieldValues []string
@@ -54,18 +219,52 @@ func (c *Config) Validate() error {
 	}{
 		{
 			fieldValues: []string{
-				c.Gardener.KubeconfigPath,
-				c.Gardener.SeedMapName,
-				c.Gardener.SeedMapNamespace,
+				c.Metrics.BindAddress,
+				c.API.BindAddress,
+				c.LeaderElection.ID,
+				c.LeaderElection.Namespace,
 			},
 			validators: []func(string) bool{isNotEmpty},
 		},
 		{
 			fieldValues: []string{
-				c.Gardener.Timeout,
+				c.Controller.Debounce,
 			},
 			validators: []func(string) bool{isValidDuration},
 		},
+		{
+			fieldValues: []string{
+				c.RunTimeout,
+				c.Store.FetchRefreshInterval,
+			},
+			validators: []func(string) bool{isNotNegativeDuration},
+		},
+		{
+			fieldValues: []string{
+				c.Daemon.SyncInterval,
+				c.Daemon.InitialBackoff,
+				c.Daemon.MaxBackoff,
+				c.API.Staleness,
+				c.LeaderElection.LeaseDuration,
+				c.LeaderElection.RenewDeadline,
+				c.LeaderElection.RetryPeriod,
+				c.Retry.InitialBackoff,
+				c.Retry.MaxBackoff,
+			},
+			validators: []func(string) bool{isPositiveDuration},
+		},
+		{
+			fieldValues: []string{
+				c.Mode,
+			},
+			validators: []func(string) bool{isOneOf(modes...)},
+		},
+		{
+			fieldValues: []string{
+				c.OutputSchema,
+			},
+			validators: []func(string) bool{isValidSchemaVersion},
+		},
 	} {
 		for _, isValid := range item.validators {
 			for _, value := range item.fieldValues {
@@ -76,39 +275,232 @@ func (c *Config) Validate() error {
 		}
 	}
 
-	return nil
+	if err := validate(c.Daemon.JitterFactor, []func(float64) bool{isNotNegative[float64]}); err != nil {
+		return err
+	}
+
+	if c.DryRun && c.Mode != ModeOneShot {
+		return fmt.Errorf("%w: %s is supported only in %s mode", ErrInvalidValue, FlagNameDryRun, ModeOneShot)
+	}
+
+	if c.LeaderElection.Enabled && c.Mode == ModeOneShot {
+		return fmt.Errorf("%w: %s is supported only in %s and %s mode", ErrInvalidValue, FlagNameLeaderElect, ModeDaemon, ModeController)
+	}
+
+	if mustParseDuration(c.LeaderElection.RenewDeadline) >= mustParseDuration(c.LeaderElection.LeaseDuration) {
+		return fmt.Errorf("%w: %s must be shorter than %s", ErrInvalidValue, FlagNameLeaderElectionRenewDeadline, FlagNameLeaderElectionLeaseDuration)
+	}
+
+	if err := validate(c.Store.MaxShrinkPercent, []func(float64) bool{isPercent}); err != nil {
+		return err
+	}
+
+	if err := validate(c.Daemon.MaxConsecutiveFailures, []func(int) bool{isNotNegative[int]}); err != nil {
+		return err
+	}
+
+	if err := validate(c.Retry.Attempts, []func(int) bool{isNotNegative[int]}); err != nil {
+		return err
+	}
+
+	if err := c.validateGardens(); err != nil {
+		return err
+	}
+
+	return c.validateTargets()
 }
 
 const (
+	FlagNameConfigPath                        = "config-path"
+	FlagNameMode                              = "mode"
+	FlagNameDryRun                            = "dry-run"
+	FlagNameEvents                            = "events"
+	FlagNameOutputSchema                      = "output-schema"
+	FlagNamePolicyPath                        = "policy-path"
+	FlagNameRunTimeout                        = "run-timeout"
+	FlagNameToleratedTaints                   = "tolerated-taints"
+	FlagNameControllerDebounce                = "controller-debounce"
+	FlagNameKCPKubeconfigPath                 = "kcp-kubeconfig-path"
 	FlagNameGardenerKubeconfigPath            = "gardener-kubeconfig-path"
 	FlagNameGardenerSeedConfigMapName         = "gardener-seed-map-name"
 	FlagNameGardenerSeedConfigMapNamespace    = "gardener-seed-map-namespace"
 	FlagNameGardenerTimeout                   = "gardener-timeout"
+	FlagNameGardenerVerdictMapName            = "gardener-verdict-map-name"
+	FlagNameGardenerLabelSelector             = "gardener-seed-label-selector"
+	FlagNameGardenerFieldSelector             = "gardener-seed-field-selector"
+	FlagNameGardenerPageSize                  = "gardener-page-size"
 	FlagDefaultGardenerKubeconfigPath         = "/gardener/kubeconfig"
 	FlagDefaultGardenerSeedConfigMapName      = "gardener-seeds-cache"
 	FlagDefaultGardenerSeedConfigMapNamespace = "kcp-system"
 	FlagDefaultGardenerTimeout                = "10s"
+	FlagDefaultGardenerPageSize               = 100
+	FlagDefaultMode                           = ModeOneShot
+	FlagDefaultOutputSchema                   = string(types.SchemaV1)
+	FlagDefaultControllerDebounce             = "5s"
+	FlagDefaultRunTimeout                     = "5m"
+
+	FlagNameDaemonSyncInterval              = "sync-interval"
+	FlagNameDaemonJitterFactor              = "sync-jitter-factor"
+	FlagNameDaemonInitialBackoff            = "sync-initial-backoff"
+	FlagNameDaemonMaxBackoff                = "sync-max-backoff"
+	FlagNameDaemonMaxConsecutiveFailures    = "sync-max-consecutive-failures"
+	FlagDefaultDaemonSyncInterval           = "5m"
+	FlagDefaultDaemonJitterFactor           = 0.1
+	FlagDefaultDaemonInitialBackoff         = "10s"
+	FlagDefaultDaemonMaxBackoff             = "5m"
+	FlagDefaultDaemonMaxConsecutiveFailures = 10
+
+	FlagNameOutputSink               = "output-sink"
+	FlagNameOutputFilePath           = "output-file-path"
+	FlagNameOutputFileFormat         = "output-file-format"
+	FlagNameOutputResourceAPIVersion = "output-resource-api-version"
+	FlagNameOutputResourceKind       = "output-resource-kind"
+	FlagDefaultOutputSink            = SinkConfigMap
+	FlagDefaultOutputFileFormat      = string(seeker.FileFormatYAML)
+
+	FlagNameStoreMaxShrinkPercent        = "store-max-shrink-percent"
+	FlagNameStoreAllowShrink             = "store-allow-shrink"
+	FlagNameStoreRefreshLastSync         = "store-refresh-last-sync"
+	FlagNameStoreFetchRefreshInterval    = "store-fetch-refresh-interval"
+	FlagDefaultStoreMaxShrinkPercent     = 50.0
+	FlagDefaultStoreFetchRefreshInterval = "1h"
+
+	FlagNameMetricsBindAddress    = "metrics-bind-address"
+	FlagDefaultMetricsBindAddress = ":8080"
+
+	FlagNameAPIBindAddress    = "api-bind-address"
+	FlagNameAPIStaleness      = "api-staleness"
+	FlagDefaultAPIBindAddress = apiDisabled
+	FlagDefaultAPIStaleness   = "15m"
+
+	FlagNameRetryAttempts          = "retry-attempts"
+	FlagNameRetryInitialBackoff    = "retry-initial-backoff"
+	FlagNameRetryMaxBackoff        = "retry-max-backoff"
+	FlagDefaultRetryAttempts       = 3
+	FlagDefaultRetryInitialBackoff = "500ms"
+	FlagDefaultRetryMaxBackoff     = "5s"
+
+	FlagNameLeaderElect                    = "leader-elect"
+	FlagNameLeaderElectionID               = "leader-election-id"
+	FlagNameLeaderElectionNamespace        = "leader-election-namespace"
+	FlagNameLeaderElectionLeaseDuration    = "leader-election-lease-duration"
+	FlagNameLeaderElectionRenewDeadline    = "leader-election-renew-deadline"
+	FlagNameLeaderElectionRetryPeriod      = "leader-election-retry-period"
+	FlagDefaultLeaderElectionID            = "gardener-syncer"
+	FlagDefaultLeaderElectionNamespace     = "kcp-system"
+	FlagDefaultLeaderElectionLeaseDuration = "15s"
+	FlagDefaultLeaderElectionRenewDeadline = "10s"
+	FlagDefaultLeaderElectionRetryPeriod   = "2s"
 )
 
 func NewConfigFromFlags() (Config, error) {
 	out := Config{}
 
+	flag.StringVar(&out.ConfigPath, FlagNameConfigPath, "", "A path to the config file, e.g. with the list of gardens.")
+	flag.StringVar(&out.Mode, FlagNameMode, FlagDefaultMode, fmt.Sprintf("The run mode, one of: %v.", modes))
+	flag.BoolVar(&out.DryRun, FlagNameDryRun, false, "Print the region changes and the server-side apply result instead of storing the seeds, exits with code 2 if the stored seeds would change. Supported only in oneshot mode.")
+	flag.BoolVar(&out.Events, FlagNameEvents, true, "Record Kubernetes events about the syncs against the objects the seeds cache is stored in.")
+	flag.StringVar(&out.OutputSchema, FlagNameOutputSchema, FlagDefaultOutputSchema, fmt.Sprintf("The schema version of the seeds cache, one of: %v. Only %s holds the seed details, e.g. the garden every seed comes from.", types.SchemaVersions, types.SchemaV2))
+	flag.StringVar(&out.PolicyPath, FlagNamePolicyPath, "", "A path to the seed eligibility policy file, empty uses the default policy.")
+	flag.StringVar(&out.RunTimeout, FlagNameRunTimeout, FlagDefaultRunTimeout, "The deadline of a single sync, including all the fetches and stores, 0 disables it. The timeouts of the single requests still apply.")
+	flag.StringVar(&out.ToleratedTaints, FlagNameToleratedTaints, "", "A comma separated list of seed taint keys tolerated in addition to the ones tolerated by the policy. The seeds with any taint that is not tolerated are excluded, the default policy tolerates none.")
+	flag.StringVar(&out.Controller.Debounce, FlagNameControllerDebounce, FlagDefaultControllerDebounce, "The time seed changes are collected before a single sync is run in controller mode.")
+	flag.StringVar(&out.Daemon.SyncInterval, FlagNameDaemonSyncInterval, FlagDefaultDaemonSyncInterval, "The interval between syncs in daemon mode.")
+	flag.Float64Var(&out.Daemon.JitterFactor, FlagNameDaemonJitterFactor, FlagDefaultDaemonJitterFactor, "The maximum factor the sync interval and backoff are randomly extended by in daemon mode.")
+	flag.StringVar(&out.Daemon.InitialBackoff, FlagNameDaemonInitialBackoff, FlagDefaultDaemonInitialBackoff, "The delay before the first retry of a failed sync in daemon mode, doubled on each consecutive failure.")
+	flag.StringVar(&out.Daemon.MaxBackoff, FlagNameDaemonMaxBackoff, FlagDefaultDaemonMaxBackoff, "The maximum delay between retries of a failed sync in daemon mode.")
+	flag.IntVar(&out.Daemon.MaxConsecutiveFailures, FlagNameDaemonMaxConsecutiveFailures, FlagDefaultDaemonMaxConsecutiveFailures, "The number of consecutive failed syncs after which the daemon exits, 0 means never.")
+	flag.StringVar(&out.Output.Sink, FlagNameOutputSink, FlagDefaultOutputSink, fmt.Sprintf("The sink the seeds cache is written to, one of: %v.", sinks))
+	flag.StringVar(&out.Output.FilePath, FlagNameOutputFilePath, "", fmt.Sprintf("The path of the file the seeds cache is written to by the '%s' sink.", SinkFile))
+	flag.StringVar(&out.Output.FileFormat, FlagNameOutputFileFormat, FlagDefaultOutputFileFormat, fmt.Sprintf("The format of the file written by the '%s' sink, one of: %v.", SinkFile, seeker.FileFormats))
+	flag.StringVar(&out.Output.ResourceAPIVersion, FlagNameOutputResourceAPIVersion, "", fmt.Sprintf("The API version of the custom resource written by the '%s' sink.", SinkCustomResource))
+	flag.StringVar(&out.Output.ResourceKind, FlagNameOutputResourceKind, "", fmt.Sprintf("The kind of the custom resource written by the '%s' sink.", SinkCustomResource))
+	flag.Float64Var(&out.Store.MaxShrinkPercent, FlagNameStoreMaxShrinkPercent, FlagDefaultStoreMaxShrinkPercent, "The largest drop of the number of stored regions, in percent, that is stored without the override.")
+	flag.BoolVar(&out.Store.AllowShrink, FlagNameStoreAllowShrink, false, fmt.Sprintf("Store the regions even if their number drops to zero or by more than %s, or a provider loses all its regions, the '%s' annotation on the stored config-map does the same.", FlagNameStoreMaxShrinkPercent, seeker.AllowShrinkAnnotation))
+	flag.BoolVar(&out.Store.RefreshLastSync, FlagNameStoreRefreshLastSync, false, fmt.Sprintf("Write the config-map even if the seeds did not change, to refresh the '%s' annotation.", seeker.LastSyncAnnotation))
+	flag.StringVar(&out.Store.FetchRefreshInterval, FlagNameStoreFetchRefreshInterval, FlagDefaultStoreFetchRefreshInterval, fmt.Sprintf("The age of the '%s' annotation after which the config-map is written even if the seeds did not change, 0 disables it.", seeker.LastSuccessfulFetchAnnotation))
+	flag.StringVar(&out.Metrics.BindAddress, FlagNameMetricsBindAddress, FlagDefaultMetricsBindAddress, fmt.Sprintf("The address the metrics endpoint binds to in daemon and controller mode, '%s' disables it.", metricsDisabled))
+	flag.StringVar(&out.API.BindAddress, FlagNameAPIBindAddress, FlagDefaultAPIBindAddress, fmt.Sprintf("The address the read API serving the seeds cache binds to in daemon mode, '%s' disables it.", apiDisabled))
+	flag.StringVar(&out.API.Staleness, FlagNameAPIStaleness, FlagDefaultAPIStaleness, "The time after the last successful sync the read API reports as not ready and not healthy.")
+	flag.IntVar(&out.Retry.Attempts, FlagNameRetryAttempts, FlagDefaultRetryAttempts, "The maximum number of attempts of a request to gardener or KCP failed with a retriable error, e.g. too many requests, a server error or a lost connection. 1 or less disables the retries.")
+	flag.StringVar(&out.Retry.InitialBackoff, FlagNameRetryInitialBackoff, FlagDefaultRetryInitialBackoff, "The delay before the first retry of a failed request, doubled on each retry.")
+	flag.StringVar(&out.Retry.MaxBackoff, FlagNameRetryMaxBackoff, FlagDefaultRetryMaxBackoff, "The maximum delay before a retry of a failed request, it bounds the delay requested by the server with Retry-After as well.")
+	flag.BoolVar(&out.LeaderElection.Enabled, FlagNameLeaderElect, false, "Elect a leader with a Lease in KCP in daemon and controller mode, so only one replica fetches and stores the seeds at a time.")
+	flag.StringVar(&out.LeaderElection.ID, FlagNameLeaderElectionID, FlagDefaultLeaderElectionID, "The name of the Lease used for the leader election.")
+	flag.StringVar(&out.LeaderElection.Namespace, FlagNameLeaderElectionNamespace, FlagDefaultLeaderElectionNamespace, "The namespace of the Lease used for the leader election.")
+	flag.StringVar(&out.LeaderElection.LeaseDuration, FlagNameLeaderElectionLeaseDuration, FlagDefaultLeaderElectionLeaseDuration, "The time the other replicas wait before taking over a Lease that is not renewed.")
+	flag.StringVar(&out.LeaderElection.RenewDeadline, FlagNameLeaderElectionRenewDeadline, FlagDefaultLeaderElectionRenewDeadline, "The time the leader retries to renew the Lease before it stops leading.")
+	flag.StringVar(&out.LeaderElection.RetryPeriod, FlagNameLeaderElectionRetryPeriod, FlagDefaultLeaderElectionRetryPeriod, "The time between the attempts to acquire or renew the Lease.")
+	flag.StringVar(&out.KCP.KubeconfigPath, FlagNameKCPKubeconfigPath, "", "A path to KCP kubeconfig file, empty uses the in-cluster config or the KUBECONFIG environment variable.")
 	flag.StringVar(&out.Gardener.KubeconfigPath, FlagNameGardenerKubeconfigPath, FlagDefaultGardenerKubeconfigPath, "A path to gardener kubeconfig file.")
 	flag.StringVar(&out.Gardener.SeedMapName, FlagNameGardenerSeedConfigMapName, FlagDefaultGardenerSeedConfigMapName, "The name of the config-map that will store gardener seeds.")
 	flag.StringVar(&out.Gardener.SeedMapNamespace, FlagNameGardenerSeedConfigMapNamespace, FlagDefaultGardenerSeedConfigMapNamespace, "The namespace of the config-map that will store gardener seeds.")
-	flag.StringVar(&out.Gardener.Timeout, FlagNameGardenerTimeout, FlagDefaultGardenerTimeout, "Gardener client timeout duration.")
+	flag.StringVar(&out.Gardener.Timeout, FlagNameGardenerTimeout, FlagDefaultGardenerTimeout, "Gardener client timeout duration, applied to every listed page of seeds.")
+	flag.IntVar(&out.Gardener.PageSize, FlagNameGardenerPageSize, FlagDefaultGardenerPageSize, "The number of gardener seeds listed at once, 0 lists all the seeds in one request. Not used in controller mode.")
+	flag.StringVar(&out.Gardener.LabelSelector, FlagNameGardenerLabelSelector, "", "The label selector of the listed gardener seeds, e.g. 'seed.gardener.cloud/kyma=true'.")
+	flag.StringVar(&out.Gardener.FieldSelector, FlagNameGardenerFieldSelector, "", "The field selector of the listed gardener seeds.")
+	flag.StringVar(&out.Gardener.VerdictMapName, FlagNameGardenerVerdictMapName, "", "The name of the config-map that will store why gardener seeds were rejected, empty disables it.")
 
 	flag.Parse()
 
+	if out.ConfigPath != "" {
+		var err error
+		if out.File, err = loadFileConfig(out.ConfigPath); err != nil {
+			return Config{}, err
+		}
+	}
+
 	if err := out.Validate(); err != nil {
 		return Config{}, err
 	}
 
 	slog.Info("configuration parsed",
+		FlagNameConfigPath, out.ConfigPath,
+		"gardens", len(out.gardens()),
+		"targets", len(out.targets()),
+		FlagNameKCPKubeconfigPath, out.KCP.KubeconfigPath,
+		FlagNameMode, out.Mode,
+		FlagNameDryRun, out.DryRun,
+		FlagNameEvents, out.Events,
+		FlagNameOutputSchema, out.OutputSchema,
+		FlagNamePolicyPath, out.PolicyPath,
+		FlagNameRunTimeout, out.RunTimeout,
+		FlagNameToleratedTaints, out.ToleratedTaints,
+		FlagNameControllerDebounce, out.Controller.Debounce,
+		FlagNameDaemonSyncInterval, out.Daemon.SyncInterval,
+		FlagNameDaemonJitterFactor, out.Daemon.JitterFactor,
+		FlagNameDaemonInitialBackoff, out.Daemon.InitialBackoff,
+		FlagNameDaemonMaxBackoff, out.Daemon.MaxBackoff,
+		FlagNameDaemonMaxConsecutiveFailures, out.Daemon.MaxConsecutiveFailures,
+		FlagNameOutputSink, out.Output.Sink,
+		FlagNameOutputFilePath, out.Output.FilePath,
+		FlagNameOutputFileFormat, out.Output.FileFormat,
+		FlagNameOutputResourceAPIVersion, out.Output.ResourceAPIVersion,
+		FlagNameOutputResourceKind, out.Output.ResourceKind,
+		FlagNameStoreMaxShrinkPercent, out.Store.MaxShrinkPercent,
+		FlagNameStoreAllowShrink, out.Store.AllowShrink,
+		FlagNameStoreRefreshLastSync, out.Store.RefreshLastSync,
+		FlagNameStoreFetchRefreshInterval, out.Store.FetchRefreshInterval,
+		FlagNameMetricsBindAddress, out.Metrics.BindAddress,
+		FlagNameAPIBindAddress, out.API.BindAddress,
+		FlagNameAPIStaleness, out.API.Staleness,
+		FlagNameRetryAttempts, out.Retry.Attempts,
+		FlagNameRetryInitialBackoff, out.Retry.InitialBackoff,
+		FlagNameRetryMaxBackoff, out.Retry.MaxBackoff,
+		FlagNameLeaderElect, out.LeaderElection.Enabled,
+		FlagNameLeaderElectionID, out.LeaderElection.ID,
+		FlagNameLeaderElectionNamespace, out.LeaderElection.Namespace,
+		FlagNameLeaderElectionLeaseDuration, out.LeaderElection.LeaseDuration,
+		FlagNameLeaderElectionRenewDeadline, out.LeaderElection.RenewDeadline,
+		FlagNameLeaderElectionRetryPeriod, out.LeaderElection.RetryPeriod,
 		FlagNameGardenerKubeconfigPath, out.Gardener.KubeconfigPath,
 		FlagNameGardenerSeedConfigMapName, out.Gardener.SeedMapName,
 		FlagNameGardenerSeedConfigMapNamespace, out.Gardener.SeedMapNamespace,
 		FlagNameGardenerTimeout, out.Gardener.Timeout,
+		FlagNameGardenerVerdictMapName, out.Gardener.VerdictMapName,
+		FlagNameGardenerLabelSelector, out.Gardener.LabelSelector,
+		FlagNameGardenerFieldSelector, out.Gardener.FieldSelector,
+		FlagNameGardenerPageSize, out.Gardener.PageSize,
 	)
 
 	return out, nil
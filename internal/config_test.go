@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	cli "github.com/kyma-project/gardener-syncer/internal"
@@ -35,6 +36,176 @@ func TestNewConfigFromFlags(t *testing.T) {
 				fmt.Sprintf("-%s", cli.FlagNameGardenerKubeconfigPath), "config.go",
 			},
 		},
+		{
+			name: "OK3: controller mode",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameMode), cli.ModeController,
+				fmt.Sprintf("-%s", cli.FlagNameControllerDebounce), "1s",
+			},
+		},
+		{
+			name: "OK4: daemon mode",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameMode), cli.ModeDaemon,
+				fmt.Sprintf("-%s", cli.FlagNameDaemonSyncInterval), "1m",
+				fmt.Sprintf("-%s", cli.FlagNameDaemonJitterFactor), "0",
+				fmt.Sprintf("-%s", cli.FlagNameDaemonMaxConsecutiveFailures), "0",
+			},
+		},
+		{
+			name: "OK5: output schema v2",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameOutputSchema), "v2",
+			},
+		},
+		{
+			name: "OK6: explicit kcp kubeconfig",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameKCPKubeconfigPath), "/kcp/kubeconfig",
+			},
+		},
+		{
+			name: "OK7: shrink allowed",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameStoreMaxShrinkPercent), "100",
+				fmt.Sprintf("-%s", cli.FlagNameStoreAllowShrink),
+			},
+		},
+		{
+			name: "OK8: read API in daemon mode",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameMode), cli.ModeDaemon,
+				fmt.Sprintf("-%s", cli.FlagNameAPIBindAddress), ":8081",
+				fmt.Sprintf("-%s", cli.FlagNameAPIStaleness), "30m",
+			},
+		},
+		{
+			name: "OK9: leader election in daemon mode",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameMode), cli.ModeDaemon,
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElect),
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElectionLeaseDuration), "30s",
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElectionRenewDeadline), "20s",
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElectionRetryPeriod), "5s",
+			},
+		},
+		{
+			name: "OK10: retries",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameRetryAttempts), "5",
+				fmt.Sprintf("-%s", cli.FlagNameRetryInitialBackoff), "1s",
+				fmt.Sprintf("-%s", cli.FlagNameRetryMaxBackoff), "30s",
+			},
+		},
+		{
+			name: "ERR1: invalid mode",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameMode), "invalid",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR2: invalid controller debounce",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameControllerDebounce), "invalid",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR3: zero sync interval",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameDaemonSyncInterval), "0s",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR4: negative max consecutive failures",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameDaemonMaxConsecutiveFailures), "-1",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR5: unsupported output schema",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameOutputSchema), "v0",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR6: invalid label selector",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameGardenerLabelSelector), "seed.gardener.cloud/kyma in (true",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR7: max shrink percent over 100",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameStoreMaxShrinkPercent), "101",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR8: dry-run in daemon mode",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameMode), cli.ModeDaemon,
+				fmt.Sprintf("-%s", cli.FlagNameDryRun),
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR9: zero API staleness",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameAPIStaleness), "0s",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR10: leader election in oneshot mode",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElect),
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR11: renew deadline not shorter than lease duration",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameMode), cli.ModeController,
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElect),
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElectionLeaseDuration), "10s",
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElectionRenewDeadline), "10s",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR12: zero retry period",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameLeaderElectionRetryPeriod), "0s",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR13: negative run timeout",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameRunTimeout), "-1s",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR14: negative retry attempts",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameRetryAttempts), "-1",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR15: zero retry max backoff",
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameRetryMaxBackoff), "0s",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -59,3 +230,151 @@ func TestNewConfigFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestNewConfigFromFlags_ConfigFile(t *testing.T) {
+	testCases := []struct {
+		name          string
+		data          string
+		args          []string
+		expectedError error
+	}{
+		{
+			name: "OK: gardens",
+			data: `gardens:
+- name: test-garden1
+  kubeconfigPath: /gardens/test-garden1/kubeconfig
+- name: test-garden2
+  kubeconfigPath: /gardens/test-garden2/kubeconfig
+  timeout: 30s
+  labelSelector: seed.gardener.cloud/kyma=true
+  pageSize: 0`,
+		},
+		{
+			name: "OK: targets",
+			data: `targets:
+- name: kcp-dev
+  kubeconfigPath: /targets/kcp-dev/kubeconfig
+- name: kcp-stage
+  kubeconfigPath: /targets/kcp-stage/kubeconfig
+  seedMapNamespace: kyma-system
+  verdictMapName: gardener-seeds-verdicts`,
+		},
+		{
+			name: "OK: sinks",
+			data: `targets:
+- name: kcp
+- name: kcp-secret
+  sink: secret
+- name: local
+  sink: file
+  filePath: /tmp/gardener-seeds-cache.json
+  fileFormat: json
+- name: kcp-resource
+  sink: customresource
+  resourceAPIVersion: infrastructuremanager.kyma-project.io/v1
+  resourceKind: SeedsCache
+- name: kcp-catalog
+  sink: catalog
+  seedMapName: gardener-seeds`,
+		},
+		{
+			name:          "ERR: unknown field",
+			data:          `unknown: true`,
+			expectedError: cli.ErrInvalidConfigFile,
+		},
+		{
+			name: "ERR: garden name not unique",
+			data: `gardens:
+- name: test-garden
+  kubeconfigPath: /gardens/test-garden1/kubeconfig
+- name: test-garden
+  kubeconfigPath: /gardens/test-garden2/kubeconfig`,
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR: garden without kubeconfig",
+			data: `gardens:
+- name: test-garden`,
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR: garden with invalid timeout",
+			data: `gardens:
+- name: test-garden
+  kubeconfigPath: /gardens/test-garden/kubeconfig
+  timeout: invalid`,
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR: garden with invalid label selector from the flag",
+			data: `gardens:
+- name: test-garden
+  kubeconfigPath: /gardens/test-garden/kubeconfig`,
+			args: []string{
+				fmt.Sprintf("-%s", cli.FlagNameGardenerLabelSelector), "seed.gardener.cloud/kyma in (true",
+			},
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR: target name not unique",
+			data: `targets:
+- name: kcp
+  kubeconfigPath: /targets/kcp-dev/kubeconfig
+- name: kcp
+  kubeconfigPath: /targets/kcp-stage/kubeconfig`,
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR: unknown sink",
+			data: `targets:
+- name: kcp
+  sink: unknown`,
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR: file sink without path",
+			data: `targets:
+- name: local
+  sink: file`,
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR: custom resource sink without kind",
+			data: `targets:
+- name: kcp
+  sink: customresource
+  resourceAPIVersion: infrastructuremanager.kyma-project.io/v1`,
+			expectedError: cli.ErrInvalidValue,
+		},
+		{
+			name: "ERR: target without name",
+			data: `targets:
+- kubeconfigPath: /targets/kcp/kubeconfig`,
+			expectedError: cli.ErrInvalidValue,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(testCase.data), 0o600))
+
+			os.Args = append([]string{"test", fmt.Sprintf("-%s", cli.FlagNameConfigPath), path}, testCase.args...)
+			flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+			// WHEN
+			_, err := cli.NewConfigFromFlags()
+
+			// THEN
+			if testCase.expectedError == nil {
+				require.NoError(t, err)
+			}
+
+			// THEN
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/kyma-project/gardener-syncer/internal/controller"
+	"github.com/kyma-project/gardener-syncer/internal/k8s/client"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+// runController keeps the seeds cache up to date by watching gardener seeds
+// instead of listing them once. The manager runs against KCP and every
+// garden is added to it as a cluster, the seeds are read from the informer
+// caches of the gardens.
+func runController(ctx context.Context, cfg Config, p pipeline) error {
+	ctrl.SetLogger(logr.FromSlogHandler(slog.Default().Handler()))
+
+	kcpOpts := cfg.kcpClientOptions()
+	kcpScheme, err := client.NewScheme(kcpOpts)
+	if err != nil {
+		return err
+	}
+
+	kcpRestConfig, err := client.NewRestConfig(kcpOpts)
+	if err != nil {
+		return err
+	}
+
+	leaseDuration := mustParseDuration(cfg.LeaderElection.LeaseDuration)
+	renewDeadline := mustParseDuration(cfg.LeaderElection.RenewDeadline)
+	retryPeriod := mustParseDuration(cfg.LeaderElection.RetryPeriod)
+	mgr, err := ctrl.NewManager(kcpRestConfig, ctrl.Options{
+		Scheme: kcpScheme,
+		Metrics: metricsserver.Options{
+			BindAddress: cfg.Metrics.BindAddress,
+		},
+		// the controllers run only in the leader, the Lease is released on
+		// SIGTERM so the next replica takes over without waiting for it to
+		// expire
+		LeaderElection:                cfg.LeaderElection.Enabled,
+		LeaderElectionID:              cfg.LeaderElection.ID,
+		LeaderElectionNamespace:       cfg.LeaderElection.Namespace,
+		LeaderElectionReleaseOnCancel: true,
+		LeaseDuration:                 &leaseDuration,
+		RenewDeadline:                 &renewDeadline,
+		RetryPeriod:                   &retryPeriod,
+	})
+	if err != nil {
+		return err
+	}
+
+	var caches []cache.Cache
+	var gardens []seeker.FetchSeedsOpts
+	for _, garden := range cfg.gardens() {
+		gardenCluster, err := newGardenCluster(garden)
+		if err != nil {
+			return err
+		}
+
+		if err := mgr.Add(gardenCluster); err != nil {
+			return err
+		}
+
+		opts, err := garden.fetchSeedsOpts(gardenCluster.GetClient().List)
+		if err != nil {
+			return err
+		}
+
+		// The informer cache is already scoped by the selectors, and it does
+		// not support field selectors without indexes, so the seeds are
+		// listed as is. Paging makes no sense for the seeds already in memory.
+		opts.Selectors = seeker.Selectors{}
+		opts.PageSize = 0
+
+		caches = append(caches, gardenCluster.GetCache())
+		gardens = append(gardens, opts)
+	}
+
+	if err := controller.SetupWithManager(mgr, controller.Options{
+		Sync:              p.buildSync(gardens),
+		Caches:            caches,
+		Debounce:          mustParseDuration(cfg.Controller.Debounce),
+		ToProviderRegions: seeker.ToProviderRegionsFn(p.evaluate, p.detailed),
+	}); err != nil {
+		return err
+	}
+
+	slog.Info("starting controller")
+	return mgr.Start(ctx)
+}
+
+func newGardenCluster(garden GardenConfig) (cluster.Cluster, error) {
+	opts := garden.clientOptions()
+	scheme, err := client.NewScheme(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	restConfig, err := client.NewRestConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	selectors, err := seeker.ParseSelectors(garden.LabelSelector, garden.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster.New(restConfig, func(o *cluster.Options) {
+		o.Scheme = scheme
+		o.Cache.ByObject = map[ctrlclient.Object]cache.ByObject{
+			&v1beta1.Seed{}: {
+				Label: selectors.Label,
+				Field: selectors.Field,
+			},
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Name is the name of the seed controller registered in the manager.
+const Name = "gardener-seeds"
+
+// SyncRequest is the only request the seed controller works on. Every seed
+// event is collapsed into it so that the whole seeds cache is rebuilt at once.
+var SyncRequest = reconcile.Request{
+	NamespacedName: k8stypes.NamespacedName{Name: Name},
+}
+
+type ToProviderRegions func([]gardener_types.Seed) types.Providers
+
+type Options struct {
+	Sync seeker.Sync
+	// Caches are the informer caches of all the gardens the seeds are watched in.
+	Caches            []cache.Cache
+	Debounce          time.Duration
+	ToProviderRegions ToProviderRegions
+}
+
+type SeedReconciler struct {
+	sync seeker.Sync
+}
+
+func (r *SeedReconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	slog.Info("seed change detected, syncing")
+	if err := r.sync(ctx); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager registers the seed controller in the manager. Seed events
+// are enqueued after the debounce period, so a burst of updates results in a
+// single sync.
+func SetupWithManager(mgr ctrl.Manager, opts Options) error {
+	builder := ctrl.NewControllerManagedBy(mgr).Named(Name)
+	for _, seedCache := range opts.Caches {
+		builder = builder.WatchesRawSource(source.Kind(
+			seedCache,
+			&gardener_types.Seed{},
+			EnqueueSyncRequest(opts.Debounce),
+			SeedStateChanged(opts.ToProviderRegions),
+		))
+	}
+
+	return builder.Complete(&SeedReconciler{sync: opts.Sync})
+}
+
+type queue = workqueue.TypedRateLimitingInterface[reconcile.Request]
+
+// EnqueueSyncRequest returns an event handler that enqueues the SyncRequest
+// after the debounce period. The work queue deduplicates waiting items, so
+// all the events received during the debounce period trigger one sync.
+func EnqueueSyncRequest(debounce time.Duration) handler.TypedEventHandler[*gardener_types.Seed, reconcile.Request] {
+	enqueue := func(q queue) {
+		q.AddAfter(SyncRequest, debounce)
+	}
+
+	return handler.TypedFuncs[*gardener_types.Seed, reconcile.Request]{
+		CreateFunc: func(_ context.Context, _ event.TypedCreateEvent[*gardener_types.Seed], q queue) {
+			enqueue(q)
+		},
+		UpdateFunc: func(_ context.Context, _ event.TypedUpdateEvent[*gardener_types.Seed], q queue) {
+			enqueue(q)
+		},
+		DeleteFunc: func(_ context.Context, _ event.TypedDeleteEvent[*gardener_types.Seed], q queue) {
+			enqueue(q)
+		},
+		GenericFunc: func(_ context.Context, _ event.TypedGenericEvent[*gardener_types.Seed], q queue) {
+			enqueue(q)
+		},
+	}
+}
+
+// SeedStateChanged filters out seed updates that do not change the seeds
+// cache, e.g. heartbeats of the gardenlet. An update passes only if the seed
+// changed its readiness, visibility, deletion state or provider region.
+func SeedStateChanged(toProviderRegions ToProviderRegions) predicate.TypedPredicate[*gardener_types.Seed] {
+	return predicate.TypedFuncs[*gardener_types.Seed]{
+		UpdateFunc: func(e event.TypedUpdateEvent[*gardener_types.Seed]) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return true
+			}
+
+			before := toProviderRegions([]gardener_types.Seed{*e.ObjectOld})
+			after := toProviderRegions([]gardener_types.Seed{*e.ObjectNew})
+			return !reflect.DeepEqual(before, after)
+		},
+	}
+}
@@ -0,0 +1,138 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/gardener-syncer/internal/controller"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var (
+	testSeedReady = gardener_types.Seed{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-seed",
+		},
+		Spec: gardener_types.SeedSpec{
+			Provider: gardener_types.SeedProvider{
+				Type:   "test-provider",
+				Region: "test-region",
+			},
+			Settings: &gardener_types.SeedSettings{
+				Scheduling: &gardener_types.SeedSettingScheduling{
+					Visible: true,
+				},
+			},
+		},
+		Status: gardener_types.SeedStatus{
+			Conditions: []gardener_types.Condition{
+				{
+					Type:   gardener_types.SeedGardenletReady,
+					Status: gardener_types.ConditionTrue,
+				},
+			},
+			LastOperation: &gardener_types.LastOperation{},
+		},
+	}
+)
+
+func withSeed(seed gardener_types.Seed, modify func(*gardener_types.Seed)) *gardener_types.Seed {
+	out := seed.DeepCopy()
+	modify(out)
+	return out
+}
+
+func TestSeedStateChanged(t *testing.T) {
+	testCases := []struct {
+		name     string
+		old      *gardener_types.Seed
+		new      *gardener_types.Seed
+		expected bool
+	}{
+		{
+			name:     "no change",
+			old:      testSeedReady.DeepCopy(),
+			new:      testSeedReady.DeepCopy(),
+			expected: false,
+		},
+		{
+			name: "irrelevant change",
+			old:  testSeedReady.DeepCopy(),
+			new: withSeed(testSeedReady, func(s *gardener_types.Seed) {
+				s.ResourceVersion = "2"
+				s.Status.Conditions[0].LastUpdateTime = metav1.Now()
+			}),
+			expected: false,
+		},
+		{
+			name: "readiness changed",
+			old:  testSeedReady.DeepCopy(),
+			new: withSeed(testSeedReady, func(s *gardener_types.Seed) {
+				s.Status.Conditions[0].Status = gardener_types.ConditionFalse
+			}),
+			expected: true,
+		},
+		{
+			name: "visibility changed",
+			old:  testSeedReady.DeepCopy(),
+			new: withSeed(testSeedReady, func(s *gardener_types.Seed) {
+				s.Spec.Settings.Scheduling.Visible = false
+			}),
+			expected: true,
+		},
+		{
+			name: "deletion started",
+			old:  testSeedReady.DeepCopy(),
+			new: withSeed(testSeedReady, func(s *gardener_types.Seed) {
+				s.DeletionTimestamp = &metav1.Time{}
+			}),
+			expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			predicate := controller.SeedStateChanged(seeker.ToProviderRegions)
+
+			// WHEN
+			actual := predicate.Update(event.TypedUpdateEvent[*gardener_types.Seed]{
+				ObjectOld: testCase.old,
+				ObjectNew: testCase.new,
+			})
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestEnqueueSyncRequest(t *testing.T) {
+	// GIVEN
+	q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer q.ShutDown()
+
+	handler := controller.EnqueueSyncRequest(50 * time.Millisecond)
+	ctx := context.Background()
+
+	// WHEN
+	handler.Create(ctx, event.TypedCreateEvent[*gardener_types.Seed]{Object: testSeedReady.DeepCopy()}, q)
+	handler.Update(ctx, event.TypedUpdateEvent[*gardener_types.Seed]{ObjectOld: testSeedReady.DeepCopy(), ObjectNew: testSeedReady.DeepCopy()}, q)
+	handler.Delete(ctx, event.TypedDeleteEvent[*gardener_types.Seed]{Object: testSeedReady.DeepCopy()}, q)
+
+	// THEN
+	require.Equal(t, 0, q.Len())
+	require.Eventually(t, func() bool {
+		return q.Len() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	item, _ := q.Get()
+	require.Equal(t, controller.SyncRequest, item)
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/kyma-project/gardener-syncer/internal/k8s/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+var ErrLeaderElectionLost = errors.New("leader election lost")
+
+// runElected runs the function only while this replica holds the Lease.
+// The Lease is released after the function returns, so the next replica
+// takes over without waiting for it to expire and never writes at the same
+// time. It fails with ErrLeaderElectionLost if the Lease could not be
+// renewed, the process should exit as its last write may be overwritten.
+func runElected(ctx context.Context, cfg Config, run func(context.Context) error, leading func(bool)) error {
+	restConfig, err := client.NewRestConfig(cfg.kcpClientOptions())
+	if err != nil {
+		return err
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+
+	identity, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	identity += "_" + string(uuid.NewUUID())
+
+	started := make(chan context.Context, 1)
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      cfg.LeaderElection.ID,
+				Namespace: cfg.LeaderElection.Namespace,
+			},
+			Client: clientset.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: identity,
+			},
+		},
+		LeaseDuration:   mustParseDuration(cfg.LeaderElection.LeaseDuration),
+		RenewDeadline:   mustParseDuration(cfg.LeaderElection.RenewDeadline),
+		RetryPeriod:     mustParseDuration(cfg.LeaderElection.RetryPeriod),
+		ReleaseOnCancel: true,
+		Name:            cfg.LeaderElection.ID,
+		Callbacks: leaderelection.LeaderCallbacks{
+			// the function is run by the caller, so the Lease is released
+			// only after it returned
+			OnStartedLeading: func(leaderCtx context.Context) {
+				started <- leaderCtx
+			},
+			OnStoppedLeading: func() {
+				leading(false)
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	electionCtx, release := context.WithCancel(context.Background())
+	defer release()
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		elector.Run(electionCtx)
+	}()
+
+	logger := slog.With("lease", cfg.LeaderElection.ID, "identity", identity)
+	logger.Info("waiting for leadership")
+	select {
+	case <-ctx.Done():
+		release()
+		<-stopped
+		return nil
+	case <-stopped:
+		return ErrLeaderElectionLost
+	case leaderCtx := <-started:
+		logger.Info("leading")
+		leading(true)
+
+		runCtx, cancel := context.WithCancel(leaderCtx)
+		defer cancel()
+		stopRun := context.AfterFunc(ctx, cancel)
+		defer stopRun()
+
+		err := run(runCtx)
+		release()
+		<-stopped
+		logger.Info("lease released")
+
+		if err != nil {
+			return err
+		}
+		if ctx.Err() == nil {
+			return ErrLeaderElectionLost
+		}
+		return nil
+	}
+}
@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// GardenConfig configures one of the gardens the seeds are fetched from. The
+// optional fields default to the values of the gardener flags.
+type GardenConfig struct {
+	Name           string `json:"name"`
+	KubeconfigPath string `json:"kubeconfigPath"`
+	Timeout        string `json:"timeout,omitempty"`
+	LabelSelector  string `json:"labelSelector,omitempty"`
+	FieldSelector  string `json:"fieldSelector,omitempty"`
+	PageSize       *int   `json:"pageSize,omitempty"`
+}
+
+// TargetConfig configures one of the sinks the seeds cache is written to.
+// The optional fields default to the values of the KCP, gardener and output
+// flags.
+type TargetConfig struct {
+	Name             string `json:"name"`
+	Sink             string `json:"sink,omitempty"`
+	KubeconfigPath   string `json:"kubeconfigPath,omitempty"`
+	SeedMapName      string `json:"seedMapName,omitempty"`
+	SeedMapNamespace string `json:"seedMapNamespace,omitempty"`
+	VerdictMapName   string `json:"verdictMapName,omitempty"`
+	// FilePath and FileFormat configure the file sink.
+	FilePath   string `json:"filePath,omitempty"`
+	FileFormat string `json:"fileFormat,omitempty"`
+	// ResourceAPIVersion and ResourceKind configure the custom resource sink.
+	ResourceAPIVersion string `json:"resourceAPIVersion,omitempty"`
+	ResourceKind       string `json:"resourceKind,omitempty"`
+}
+
+// FileConfig is the part of the configuration that does not fit into flags.
+type FileConfig struct {
+	Gardens []GardenConfig `json:"gardens,omitempty"`
+	Targets []TargetConfig `json:"targets,omitempty"`
+}
+
+var ErrInvalidConfigFile = fmt.Errorf("invalid config file")
+
+func loadFileConfig(path string) (FileConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return FileConfig{}, err
+	}
+
+	var out FileConfig
+	if err := yaml.UnmarshalStrict(data, &out); err != nil {
+		return FileConfig{}, fmt.Errorf("%w: %w", ErrInvalidConfigFile, err)
+	}
+
+	return out, nil
+}
+
+// gardens returns the gardens from the config file with the defaults
+// applied, or the single garden configured with the gardener flags.
+func (c *Config) gardens() []GardenConfig {
+	if len(c.File.Gardens) == 0 {
+		return []GardenConfig{
+			{
+				KubeconfigPath: c.Gardener.KubeconfigPath,
+				Timeout:        c.Gardener.Timeout,
+				LabelSelector:  c.Gardener.LabelSelector,
+				FieldSelector:  c.Gardener.FieldSelector,
+				PageSize:       &c.Gardener.PageSize,
+			},
+		}
+	}
+
+	out := make([]GardenConfig, 0, len(c.File.Gardens))
+	for _, garden := range c.File.Gardens {
+		if garden.Timeout == "" {
+			garden.Timeout = c.Gardener.Timeout
+		}
+
+		if garden.LabelSelector == "" {
+			garden.LabelSelector = c.Gardener.LabelSelector
+		}
+
+		if garden.FieldSelector == "" {
+			garden.FieldSelector = c.Gardener.FieldSelector
+		}
+
+		if garden.PageSize == nil {
+			garden.PageSize = &c.Gardener.PageSize
+		}
+
+		out = append(out, garden)
+	}
+	return out
+}
+
+func (c *Config) validateGardens() error {
+	names := map[string]bool{}
+	for _, garden := range c.File.Gardens {
+		if garden.Name == "" || names[garden.Name] {
+			return fmt.Errorf("%w: garden name '%s' empty or not unique", ErrInvalidValue, garden.Name)
+		}
+		names[garden.Name] = true
+	}
+
+	for _, garden := range c.gardens() {
+		for _, item := range []struct {
+			value   string
+			isValid func(string) bool
+		}{
+			{garden.KubeconfigPath, isNotEmpty},
+			{garden.Timeout, isValidDuration},
+			{garden.LabelSelector, isValidLabelSelector},
+			{garden.FieldSelector, isValidFieldSelector},
+		} {
+			if err := validate(item.value, []func(string) bool{item.isValid}); err != nil {
+				return fmt.Errorf("garden '%s': %w", garden.Name, err)
+			}
+		}
+
+		if err := validate(*garden.PageSize, []func(int) bool{isNotNegative[int]}); err != nil {
+			return fmt.Errorf("garden '%s': %w", garden.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// targets returns the targets from the config file with the defaults
+// applied, or the single target configured with the flags.
+func (c *Config) targets() []TargetConfig {
+	if len(c.File.Targets) == 0 {
+		return []TargetConfig{
+			{
+				Sink:               c.Output.Sink,
+				KubeconfigPath:     c.KCP.KubeconfigPath,
+				SeedMapName:        c.Gardener.SeedMapName,
+				SeedMapNamespace:   c.Gardener.SeedMapNamespace,
+				VerdictMapName:     c.Gardener.VerdictMapName,
+				FilePath:           c.Output.FilePath,
+				FileFormat:         c.Output.FileFormat,
+				ResourceAPIVersion: c.Output.ResourceAPIVersion,
+				ResourceKind:       c.Output.ResourceKind,
+			},
+		}
+	}
+
+	out := make([]TargetConfig, 0, len(c.File.Targets))
+	for _, target := range c.File.Targets {
+		if target.Sink == "" {
+			target.Sink = c.Output.Sink
+		}
+
+		if target.KubeconfigPath == "" {
+			target.KubeconfigPath = c.KCP.KubeconfigPath
+		}
+
+		if target.SeedMapName == "" {
+			target.SeedMapName = c.Gardener.SeedMapName
+		}
+
+		if target.SeedMapNamespace == "" {
+			target.SeedMapNamespace = c.Gardener.SeedMapNamespace
+		}
+
+		if target.VerdictMapName == "" {
+			target.VerdictMapName = c.Gardener.VerdictMapName
+		}
+
+		if target.FilePath == "" {
+			target.FilePath = c.Output.FilePath
+		}
+
+		if target.FileFormat == "" {
+			target.FileFormat = c.Output.FileFormat
+		}
+
+		if target.ResourceAPIVersion == "" {
+			target.ResourceAPIVersion = c.Output.ResourceAPIVersion
+		}
+
+		if target.ResourceKind == "" {
+			target.ResourceKind = c.Output.ResourceKind
+		}
+
+		out = append(out, target)
+	}
+	return out
+}
+
+func (c *Config) validateTargets() error {
+	names := map[string]bool{}
+	for _, target := range c.File.Targets {
+		if target.Name == "" || names[target.Name] {
+			return fmt.Errorf("%w: target name '%s' empty or not unique", ErrInvalidValue, target.Name)
+		}
+		names[target.Name] = true
+	}
+
+	for _, target := range c.targets() {
+		if err := target.validate(); err != nil {
+			return fmt.Errorf("target '%s': %w", target.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (t TargetConfig) validate() error {
+	if err := validate(t.Sink, []func(string) bool{isOneOf(sinks...)}); err != nil {
+		return err
+	}
+
+	var values []string
+	switch t.Sink {
+	case SinkFile:
+		if err := seeker.FileFormat(t.FileFormat).Validate(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+		values = []string{t.FilePath}
+	case SinkCustomResource:
+		if _, err := schema.ParseGroupVersion(t.ResourceAPIVersion); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+		values = []string{t.ResourceAPIVersion, t.ResourceKind, t.SeedMapName, t.SeedMapNamespace}
+	case SinkCatalog:
+		// the catalog is cluster-scoped
+		values = []string{t.SeedMapName}
+	default:
+		values = []string{t.SeedMapName, t.SeedMapNamespace}
+	}
+
+	for _, value := range values {
+		if err := validate(value, []func(string) bool{isNotEmpty}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t TargetConfig) seedMapKey() client.ObjectKey {
+	return client.ObjectKey{
+		Namespace: t.SeedMapNamespace,
+		Name:      t.SeedMapName,
+	}
+}
+
+func (t TargetConfig) verdictMapKey() client.ObjectKey {
+	return client.ObjectKey{
+		Namespace: t.SeedMapNamespace,
+		Name:      t.VerdictMapName,
+	}
+}
@@ -15,8 +15,7 @@ type Options struct {
 	AdditionalAddToSchema []func(*runtime.Scheme) error
 }
 
-func New(opt Options) (k8sClient client.Client, err error) {
-
+func NewScheme(opt Options) (*runtime.Scheme, error) {
 	scheme := runtime.NewScheme()
 	for _, register := range opt.AdditionalAddToSchema {
 		if err := register(scheme); err != nil {
@@ -25,7 +24,10 @@ func New(opt Options) (k8sClient client.Client, err error) {
 	}
 
 	slog.Info("schema registered")
+	return scheme, nil
+}
 
+func NewRestConfig(opt Options) (*rest.Config, error) {
 	getRestConfig := config.GetConfig
 	if opt.KubeconfigPath != "" {
 		getRestConfig = func() (*rest.Config, error) {
@@ -33,7 +35,16 @@ func New(opt Options) (k8sClient client.Client, err error) {
 		}
 	}
 
-	restConfig, err := getRestConfig()
+	return getRestConfig()
+}
+
+func New(opt Options) (k8sClient client.Client, err error) {
+	scheme, err := NewScheme(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	restConfig, err := NewRestConfig(opt)
 	if err != nil {
 		return nil, err
 	}
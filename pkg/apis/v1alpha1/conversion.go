@@ -0,0 +1,64 @@
+package v1alpha1
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+// NewSpec converts the providers into the catalog spec, the providers,
+// regions and seeds are sorted.
+func NewSpec(version types.SchemaVersion, providers types.Providers) SeedRegionCatalogSpec {
+	providers = providers.Sorted()
+	out := SeedRegionCatalogSpec{SchemaVersion: string(version)}
+	for _, providerType := range slices.Sorted(maps.Keys(providers)) {
+		providerInfo := providers[providerType]
+		provider := Provider{Type: providerType}
+		for _, regionName := range providerInfo.SeedRegions {
+			regionInfo := providerInfo.Regions[regionName]
+			region := Region{
+				Name:      regionName,
+				SeedCount: regionInfo.SeedCount,
+				Zones:     regionInfo.Zones,
+				Gardens:   regionInfo.Gardens,
+			}
+			for _, seed := range regionInfo.Seeds {
+				region.Seeds = append(region.Seeds, Seed(seed))
+			}
+			provider.Regions = append(provider.Regions, region)
+		}
+		out.Providers = append(out.Providers, provider)
+	}
+	return out
+}
+
+// ToProviders converts the catalog spec back into the providers, it is the
+// inverse of NewSpec.
+func (s SeedRegionCatalogSpec) ToProviders() (types.SchemaVersion, types.Providers) {
+	out := types.Providers{}
+	for _, provider := range s.Providers {
+		providerInfo := types.ProviderInfo{SeedRegions: []string{}}
+		for _, region := range provider.Regions {
+			providerInfo.SeedRegions = append(providerInfo.SeedRegions, region.Name)
+			if region.SeedCount == 0 && len(region.Seeds) == 0 {
+				continue
+			}
+
+			if providerInfo.Regions == nil {
+				providerInfo.Regions = map[string]types.RegionInfo{}
+			}
+			regionInfo := types.RegionInfo{
+				SeedCount: region.SeedCount,
+				Zones:     region.Zones,
+				Gardens:   region.Gardens,
+			}
+			for _, seed := range region.Seeds {
+				regionInfo.Seeds = append(regionInfo.Seeds, types.SeedInfo(seed))
+			}
+			providerInfo.Regions[region.Name] = regionInfo
+		}
+		out[provider.Type] = providerInfo
+	}
+	return types.SchemaVersion(s.SchemaVersion), out
+}
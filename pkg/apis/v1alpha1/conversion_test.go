@@ -0,0 +1,78 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/kyma-project/gardener-syncer/pkg/apis/v1alpha1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSpec(t *testing.T) {
+	capacity := int64(100)
+	detailed := types.Providers{}
+	detailed.AddSeed("gcp", "us-central1", types.SeedInfo{Name: "seed-b", Garden: "garden", Capacity: &capacity})
+	detailed.AddSeed("aws", "eu-west-1", types.SeedInfo{Name: "seed-a", Zones: []string{"eu-west-1a"}})
+
+	testCases := []struct {
+		name         string
+		version      types.SchemaVersion
+		providers    types.Providers
+		expectedSpec v1alpha1.SeedRegionCatalogSpec
+	}{
+		{
+			name:    "v1",
+			version: types.SchemaV1,
+			providers: types.Providers{
+				"gcp": {SeedRegions: []string{"us-central1", "europe-west3"}},
+				"aws": {SeedRegions: []string{"eu-west-1"}},
+			},
+			expectedSpec: v1alpha1.SeedRegionCatalogSpec{
+				SchemaVersion: "v1",
+				Providers: []v1alpha1.Provider{
+					{Type: "aws", Regions: []v1alpha1.Region{{Name: "eu-west-1"}}},
+					{Type: "gcp", Regions: []v1alpha1.Region{{Name: "europe-west3"}, {Name: "us-central1"}}},
+				},
+			},
+		},
+		{
+			name:      "v2",
+			version:   types.SchemaV2,
+			providers: detailed,
+			expectedSpec: v1alpha1.SeedRegionCatalogSpec{
+				SchemaVersion: "v2",
+				Providers: []v1alpha1.Provider{
+					{Type: "aws", Regions: []v1alpha1.Region{
+						{
+							Name:      "eu-west-1",
+							SeedCount: 1,
+							Zones:     []string{"eu-west-1a"},
+							Seeds:     []v1alpha1.Seed{{Name: "seed-a", Zones: []string{"eu-west-1a"}}},
+						},
+					}},
+					{Type: "gcp", Regions: []v1alpha1.Region{
+						{
+							Name:      "us-central1",
+							SeedCount: 1,
+							Gardens:   []string{"garden"},
+							Seeds:     []v1alpha1.Seed{{Name: "seed-b", Garden: "garden", Capacity: &capacity}},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			spec := v1alpha1.NewSpec(testCase.version, testCase.providers)
+			version, providers := spec.ToProviders()
+
+			// THEN
+			require.Equal(t, testCase.expectedSpec, spec)
+			require.Equal(t, testCase.version, version)
+			require.Equal(t, testCase.providers.Sorted(), providers)
+		})
+	}
+}
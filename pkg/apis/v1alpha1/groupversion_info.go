@@ -0,0 +1,18 @@
+// Package v1alpha1 contains the API of the resources gardener-syncer
+// publishes the seeds cache in.
+// +kubebuilder:object:generate=true
+// +groupName=gardener-syncer.kyma-project.io
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+var (
+	GroupVersion = schema.GroupVersion{Group: "gardener-syncer.kyma-project.io", Version: "v1alpha1"}
+
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+
+	AddToScheme = SchemeBuilder.AddToScheme
+)
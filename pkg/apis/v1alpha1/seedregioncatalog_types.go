@@ -0,0 +1,87 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionSynced tells if the last sync wrote the catalog.
+	ConditionSynced = "Synced"
+	// ConditionDegraded tells if the catalog holds the regions of an older
+	// sync, because the latest syncs failed.
+	ConditionDegraded = "Degraded"
+
+	ReasonSyncSucceeded = "SyncSucceeded"
+	ReasonSyncFailed    = "SyncFailed"
+	ReasonStoreRejected = "StoreRejected"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Synced",type=string,JSONPath=`.status.conditions[?(@.type=="Synced")].status`
+// +kubebuilder:printcolumn:name="Degraded",type=string,JSONPath=`.status.conditions[?(@.type=="Degraded")].status`
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastSyncTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SeedRegionCatalog lists the regions of every provider the gardener seeds
+// are available in.
+type SeedRegionCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SeedRegionCatalogSpec   `json:"spec,omitempty"`
+	Status SeedRegionCatalogStatus `json:"status,omitempty"`
+}
+
+type SeedRegionCatalogSpec struct {
+	// SchemaVersion is the schema version of the seeds cache the catalog is
+	// built from, the seed details are listed only in v2.
+	SchemaVersion string `json:"schemaVersion"`
+	// Providers are sorted by type.
+	Providers []Provider `json:"providers,omitempty"`
+}
+
+type Provider struct {
+	Type string `json:"type"`
+	// Regions are sorted by name.
+	Regions []Region `json:"regions,omitempty"`
+}
+
+type Region struct {
+	Name      string   `json:"name"`
+	SeedCount int      `json:"seedCount,omitempty"`
+	Zones     []string `json:"zones,omitempty"`
+	Gardens   []string `json:"gardens,omitempty"`
+	Seeds     []Seed   `json:"seeds,omitempty"`
+}
+
+type Seed struct {
+	Name        string            `json:"name"`
+	Garden      string            `json:"garden,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Zones       []string          `json:"zones,omitempty"`
+	Capacity    *int64            `json:"capacity,omitempty"`
+	Allocatable *int64            `json:"allocatable,omitempty"`
+}
+
+type SeedRegionCatalogStatus struct {
+	ObservedGeneration int64        `json:"observedGeneration,omitempty"`
+	LastSyncTime       *metav1.Time `json:"lastSyncTime,omitempty"`
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SeedRegionCatalogList contains a list of SeedRegionCatalog.
+type SeedRegionCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SeedRegionCatalog `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SeedRegionCatalog{}, &SeedRegionCatalogList{})
+}
@@ -0,0 +1,188 @@
+package seeker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	log "log/slog"
+
+	"github.com/kyma-project/gardener-syncer/pkg/apis/v1alpha1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type PatchStatus func(context.Context, client.Object, client.Patch, ...client.SubResourcePatchOption) error
+
+// CatalogSink stores the seeds cache in the typed spec of a cluster-scoped
+// SeedRegionCatalog, and the outcome of the syncs in its status.
+type CatalogSink struct {
+	Name string
+	// Timeout applies to the status updates of ReportFailure.
+	Timeout time.Duration
+	Get
+	Patch
+	PatchStatus
+}
+
+func (s CatalogSink) String() string {
+	return fmt.Sprintf("seed-region-catalog %s", s.Name)
+}
+
+// Read returns the spec as the document. A degraded catalog is read as
+// stale, so the next successful store writes it and resets the status even
+// if the regions did not change.
+func (s CatalogSink) Read(ctx context.Context) (Document, error) {
+	var catalog v1alpha1.SeedRegionCatalog
+	if err := s.Get(ctx, client.ObjectKey{Name: s.Name}, &catalog); err != nil {
+		return Document{}, ignoreNotFound(err)
+	}
+
+	version, providers := catalog.Spec.ToProviders()
+	if version == "" {
+		version = types.SchemaV1
+	}
+
+	data, err := types.Encode(version, providers)
+	if err != nil {
+		return Document{}, err
+	}
+
+	annotations := catalog.Annotations
+	if meta.IsStatusConditionTrue(catalog.Status.Conditions, v1alpha1.ConditionDegraded) {
+		annotations = maps.Clone(annotations)
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[StaleAnnotation] = "true"
+	}
+	return Document{Annotations: annotations, Data: data}, nil
+}
+
+func (s CatalogSink) Object(ctx context.Context) (client.Object, error) {
+	var catalog v1alpha1.SeedRegionCatalog
+	return &catalog, s.Get(ctx, client.ObjectKey{Name: s.Name}, &catalog)
+}
+
+// Write applies the spec and marks the catalog as synced.
+func (s CatalogSink) Write(ctx context.Context, doc Document) error {
+	catalog, err := s.catalog(doc)
+	if err != nil {
+		return err
+	}
+
+	if err := s.apply(ctx, catalog); err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	catalog.Status.ObservedGeneration = catalog.Generation
+	catalog.Status.LastSyncTime = &now
+	meta.SetStatusCondition(&catalog.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.ConditionSynced,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: catalog.Generation,
+		Reason:             v1alpha1.ReasonSyncSucceeded,
+		Message:            "the catalog holds the regions of the latest sync",
+	})
+	meta.SetStatusCondition(&catalog.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.ConditionDegraded,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: catalog.Generation,
+		Reason:             v1alpha1.ReasonSyncSucceeded,
+		Message:            "the catalog holds the regions of the latest sync",
+	})
+	return s.applyStatus(ctx, catalog)
+}
+
+func (s CatalogSink) DryRun(ctx context.Context, doc Document) (any, error) {
+	catalog, err := s.catalog(doc)
+	if err != nil {
+		return nil, err
+	}
+	return catalog, s.apply(ctx, catalog, client.DryRunAll)
+}
+
+// ReportFailure marks the catalog as not synced and degraded, it keeps the
+// regions of the last successful sync. It can be used as a FailureReport.
+func (s CatalogSink) ReportFailure(ctx context.Context, syncErr error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	var catalog v1alpha1.SeedRegionCatalog
+	if err := s.Get(ctx, client.ObjectKey{Name: s.Name}, &catalog); err != nil {
+		if ignoreNotFound(err) != nil {
+			log.With("error", err).Warn("unable to report sync failure")
+		}
+		return
+	}
+
+	reason := v1alpha1.ReasonSyncFailed
+	if errors.Is(syncErr, ErrStoreRejected) {
+		reason = v1alpha1.ReasonStoreRejected
+	}
+
+	meta.SetStatusCondition(&catalog.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.ConditionSynced,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: catalog.Generation,
+		Reason:             reason,
+		Message:            syncErr.Error(),
+	})
+	meta.SetStatusCondition(&catalog.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.ConditionDegraded,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: catalog.Generation,
+		Reason:             reason,
+		Message:            "the catalog holds the regions of the last successful sync",
+	})
+
+	if err := s.applyStatus(ctx, &catalog); err != nil {
+		log.With("error", err).Warn("unable to report sync failure")
+	}
+}
+
+func (s CatalogSink) catalog(doc Document) (*v1alpha1.SeedRegionCatalog, error) {
+	providers, version, err := types.Decode(doc.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := &v1alpha1.SeedRegionCatalog{
+		Spec: v1alpha1.NewSpec(version, providers),
+	}
+	catalog.Name = s.Name
+	catalog.Annotations = doc.Annotations
+	return catalog, nil
+}
+
+func (s CatalogSink) apply(ctx context.Context, catalog *v1alpha1.SeedRegionCatalog, opts ...client.PatchOption) error {
+	setCatalogTypeMeta(catalog)
+	opts = append(opts, &client.PatchOptions{
+		FieldManager: FieldManagerName,
+	})
+	return s.Patch(ctx, catalog, client.Apply, opts...)
+}
+
+// applyStatus applies only the status, the spec is owned by the apply of
+// the spec.
+func (s CatalogSink) applyStatus(ctx context.Context, catalog *v1alpha1.SeedRegionCatalog) error {
+	status := &v1alpha1.SeedRegionCatalog{Status: catalog.Status}
+	status.Name = catalog.Name
+	setCatalogTypeMeta(status)
+	return s.PatchStatus(ctx, status, client.Apply, &client.SubResourcePatchOptions{
+		PatchOptions: client.PatchOptions{
+			FieldManager: FieldManagerName,
+		},
+	})
+}
+
+func setCatalogTypeMeta(catalog *v1alpha1.SeedRegionCatalog) {
+	catalog.TypeMeta.Kind = "SeedRegionCatalog"
+	catalog.TypeMeta.APIVersion = v1alpha1.GroupVersion.String()
+	catalog.ManagedFields = nil
+}
@@ -0,0 +1,113 @@
+package seeker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/apis/v1alpha1"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCatalogSink(t *testing.T) {
+	// GIVEN
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	var status *v1alpha1.SeedRegionCatalogStatus
+	sink := seeker.CatalogSink{
+		Name:  testName,
+		Get:   c.Get,
+		Patch: buildCapturePatch(c),
+		PatchStatus: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+			status = &obj.(*v1alpha1.SeedRegionCatalog).Status
+			return nil
+		},
+	}
+
+	data, err := types.Encode(types.SchemaV2, types.Providers{
+		"aws": {
+			SeedRegions: []string{"eu-west-1"},
+			Regions: map[string]types.RegionInfo{
+				"eu-west-1": {SeedCount: 1, Seeds: []types.SeedInfo{{Name: "seed-a"}}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	doc := seeker.Document{Annotations: testDoc.Annotations, Data: data}
+
+	// WHEN
+	errWrite := sink.Write(context.Background(), doc)
+	actual, errRead := sink.Read(context.Background())
+
+	// THEN
+	require.NoError(t, errWrite)
+	require.NoError(t, errRead)
+	require.Equal(t, doc, actual)
+	require.NotNil(t, status.LastSyncTime)
+	require.True(t, meta.IsStatusConditionTrue(status.Conditions, v1alpha1.ConditionSynced))
+	require.True(t, meta.IsStatusConditionFalse(status.Conditions, v1alpha1.ConditionDegraded))
+
+	// WHEN
+	sink.ReportFailure(context.Background(), fmt.Errorf("%w: test", seeker.ErrStoreRejected))
+
+	// THEN
+	require.True(t, meta.IsStatusConditionFalse(status.Conditions, v1alpha1.ConditionSynced))
+	require.True(t, meta.IsStatusConditionTrue(status.Conditions, v1alpha1.ConditionDegraded))
+	require.Equal(t, v1alpha1.ReasonStoreRejected, meta.FindStatusCondition(status.Conditions, v1alpha1.ConditionDegraded).Reason)
+}
+
+func TestCatalogSink_Recovered(t *testing.T) {
+	// GIVEN
+	var stored v1alpha1.SeedRegionCatalog
+	sink := seeker.CatalogSink{
+		Name: testName,
+		Get: func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+			stored.DeepCopyInto(obj.(*v1alpha1.SeedRegionCatalog))
+			return nil
+		},
+		Patch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+			catalog := obj.(*v1alpha1.SeedRegionCatalog)
+			stored.Annotations = catalog.Annotations
+			stored.Spec = catalog.Spec
+			return nil
+		},
+		PatchStatus: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+			stored.Status = obj.(*v1alpha1.SeedRegionCatalog).Status
+			return nil
+		},
+	}
+	store := seeker.BuildStoreFn(seeker.StoreOpts{
+		Sink:    sink,
+		Convert: seeker.ToConfigMap,
+		Timeout: time.Second,
+	})
+	require.NoError(t, store(context.Background(), testProviderRegions))
+	sink.ReportFailure(context.Background(), errTestFailed)
+	require.True(t, meta.IsStatusConditionTrue(stored.Status.Conditions, v1alpha1.ConditionDegraded))
+
+	// WHEN
+	doc, err := sink.Read(context.Background())
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "true", doc.Annotations[seeker.StaleAnnotation])
+	require.NotContains(t, stored.Annotations, seeker.StaleAnnotation)
+
+	// WHEN
+	err = store(context.Background(), testProviderRegions)
+
+	// THEN
+	require.NoError(t, err)
+	require.True(t, meta.IsStatusConditionTrue(stored.Status.Conditions, v1alpha1.ConditionSynced))
+	require.True(t, meta.IsStatusConditionFalse(stored.Status.Conditions, v1alpha1.ConditionDegraded))
+	require.NotContains(t, stored.Annotations, seeker.StaleAnnotation)
+}
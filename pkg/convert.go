@@ -1,46 +1,57 @@
 package seeker
 
 import (
-	"log/slog"
-	"strings"
-
-	"sigs.k8s.io/yaml"
-
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
-	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/kyma-project/gardener-syncer/pkg/types"
 )
 
-func verifySeedReadiness(seed *gardener_types.Seed) bool {
-	if seed.Status.LastOperation == nil {
-		return false
+func ToProviderRegions(seeds []gardener_types.Seed) types.Providers {
+	return VerdictsToProviderRegions(EvaluateSeeds(seeds))
+}
+
+// ToDetailedProviderRegions works like ToProviderRegions, but it also
+// records the details of every usable seed.
+func ToDetailedProviderRegions(seeds []gardener_types.Seed) types.Providers {
+	return VerdictsToDetailedProviderRegions(EvaluateSeeds(seeds))
+}
+
+// ToProviderRegionsFn returns the conversion of the seeds evaluated with the
+// given function into provider regions, with or without the seed details.
+func ToProviderRegionsFn(evaluate Evaluate, detailed bool) func([]gardener_types.Seed) types.Providers {
+	toProviderRegions := VerdictsToProviderRegions
+	if detailed {
+		toProviderRegions = VerdictsToDetailedProviderRegions
 	}
 
-	if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardener_types.SeedGardenletReady); cond == nil || cond.Status != gardener_types.ConditionTrue {
-		return false
+	return func(seeds []gardener_types.Seed) types.Providers {
+		return toProviderRegions(EvaluateSeedsWith(evaluate, seeds))
 	}
+}
 
-	if seed.Spec.Backup != nil {
-		if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardener_types.SeedBackupBucketsReady); cond == nil || cond.Status != gardener_types.ConditionTrue {
-			return false
+func VerdictsToProviderRegions(verdicts []SeedVerdict) types.Providers {
+	result := types.Providers{}
+	for _, verdict := range verdicts {
+		if verdict.Usable() {
+			result.Add(
+				verdict.Provider,
+				verdict.Region,
+			)
 		}
 	}
 
-	return true
-}
-
-func seedCanBeUsed(seed *gardener_types.Seed) bool {
-	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
+	return result
 }
 
-func ToProviderRegions(seeds []gardener_types.Seed) types.Providers {
+func VerdictsToDetailedProviderRegions(verdicts []SeedVerdict) types.Providers {
 	result := types.Providers{}
-	for _, seed := range seeds {
-		slog.Debug("checking seed", "seedName", seed.Name)
-		if seedCanBeUsed(&seed) {
-			result.Add(
-				seed.Spec.Provider.Type,
-				seed.Spec.Provider.Region,
+	for _, verdict := range verdicts {
+		if verdict.Usable() {
+			details := verdict.Details
+			details.Garden = verdict.Garden
+			result.AddSeed(
+				verdict.Provider,
+				verdict.Region,
+				details,
 			)
 		}
 	}
@@ -48,16 +59,18 @@ func ToProviderRegions(seeds []gardener_types.Seed) types.Providers {
 	return result
 }
 
+// ToConfigMap converts the provider regions into config map data in
+// types.SchemaV1.
 func ToConfigMap(providerRegions types.Providers) (map[string]string, error) {
-	result := map[string]string{}
-	for k, v := range providerRegions {
-		data, err := yaml.Marshal(v)
-		if err != nil {
-			return nil, err
-		}
-		result[k] = strings.TrimRight(string(data), "\n")
+	return types.Encode(types.SchemaV1, providerRegions)
+}
+
+// ToConfigMapFn returns the conversion of the provider regions into config
+// map data in the given schema version.
+func ToConfigMapFn(version types.SchemaVersion) Convert[types.Providers, map[string]string] {
+	return func(providerRegions types.Providers) (map[string]string, error) {
+		return types.Encode(version, providerRegions)
 	}
-	return result, nil
 }
 
 type Convert[T any, V any] func(T) (V, error)
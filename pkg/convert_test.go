@@ -7,7 +7,10 @@ import (
 	seeker "github.com/kyma-project/gardener-syncer/pkg"
 	"github.com/kyma-project/gardener-syncer/pkg/types"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 var (
@@ -233,3 +236,68 @@ func TestToProvideRegions(t *testing.T) {
 		})
 	}
 }
+
+func TestToDetailedProviderRegions(t *testing.T) {
+	// GIVEN
+	seed := testSeedOK.DeepCopy()
+	seed.Name = "test-seed"
+	seed.Labels = map[string]string{"test": "label"}
+	seed.Spec.Provider.Zones = []string{"test-zone1", "test-zone2"}
+	seed.Status.Capacity = corev1.ResourceList{
+		gardener_types.ResourceShoots: resource.MustParse("200"),
+	}
+	seed.Status.Allocatable = corev1.ResourceList{
+		gardener_types.ResourceShoots: resource.MustParse("150"),
+	}
+
+	// WHEN
+	actual := seeker.ToDetailedProviderRegions([]gardener_types.Seed{*seed, testSeedInDeletion})
+
+	// THEN
+	require.Equal(t, types.Providers{
+		testProviderType1: {
+			SeedRegions: []string{testRegion1},
+			Regions: map[string]types.RegionInfo{
+				testRegion1: {
+					SeedCount: 1,
+					Zones:     []string{"test-zone1", "test-zone2"},
+					Seeds: []types.SeedInfo{
+						{
+							Name:        "test-seed",
+							Labels:      map[string]string{"test": "label"},
+							Zones:       []string{"test-zone1", "test-zone2"},
+							Capacity:    ptr.To[int64](200),
+							Allocatable: ptr.To[int64](150),
+						},
+					},
+				},
+			},
+		},
+	}, actual)
+
+	// WHEN
+	data, err := seeker.ToConfigMapFn(types.SchemaV2)(actual)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		types.SchemaVersionKey: string(types.SchemaV2),
+		testProviderType1: `regions:
+  test-region1:
+    seedCount: 1
+    seeds:
+    - allocatable: 150
+      capacity: 200
+      labels:
+        test: label
+      name: test-seed
+      zones:
+      - test-zone1
+      - test-zone2
+    zones:
+    - test-zone1
+    - test-zone2
+seedRegions:
+- test-region1`,
+	}, data)
+}
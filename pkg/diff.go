@@ -0,0 +1,77 @@
+package seeker
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+var ErrDriftDetected = fmt.Errorf("drift detected")
+
+// ProviderDiff lists the regions of a provider that are added or removed.
+type ProviderDiff struct {
+	Provider string
+	Added    []string
+	Removed  []string
+}
+
+// Diff compares the regions of every provider, the result is sorted by
+// provider and region and holds only the providers that changed.
+func Diff(before, after types.Providers) []ProviderDiff {
+	providers := slices.Collect(maps.Keys(before))
+	for provider := range after {
+		if _, found := before[provider]; !found {
+			providers = append(providers, provider)
+		}
+	}
+	slices.Sort(providers)
+
+	var out []ProviderDiff
+	for _, provider := range providers {
+		diff := ProviderDiff{
+			Provider: provider,
+			Added:    missing(after[provider].SeedRegions, before[provider].SeedRegions),
+			Removed:  missing(before[provider].SeedRegions, after[provider].SeedRegions),
+		}
+
+		if len(diff.Added) != 0 || len(diff.Removed) != 0 {
+			out = append(out, diff)
+		}
+	}
+	return out
+}
+
+// missing returns the sorted values of s that are not in other.
+func missing(s, other []string) []string {
+	var out []string
+	for _, value := range s {
+		if !slices.Contains(other, value) {
+			out = append(out, value)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// FormatDiff renders the diff in a human-readable form, a line per added
+// or removed region grouped by provider.
+func FormatDiff(diffs []ProviderDiff) string {
+	if len(diffs) == 0 {
+		return "no region changes\n"
+	}
+
+	var sb strings.Builder
+	for _, diff := range diffs {
+		fmt.Fprintf(&sb, "%s:\n", diff.Provider)
+		for _, region := range diff.Added {
+			fmt.Fprintf(&sb, "+ %s\n", region)
+		}
+		for _, region := range diff.Removed {
+			fmt.Fprintf(&sb, "- %s\n", region)
+		}
+	}
+	return sb.String()
+}
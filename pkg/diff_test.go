@@ -0,0 +1,65 @@
+package seeker_test
+
+import (
+	"testing"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	testCases := []struct {
+		name           string
+		before         types.Providers
+		after          types.Providers
+		expectedDiff   []seeker.ProviderDiff
+		expectedFormat string
+	}{
+		{
+			name: "no changes",
+			before: types.Providers{
+				"aws": {SeedRegions: []string{"eu-central-1", "eu-west-1"}},
+			},
+			after: types.Providers{
+				"aws": {SeedRegions: []string{"eu-west-1", "eu-central-1"}},
+			},
+			expectedFormat: "no region changes\n",
+		},
+		{
+			name: "regions added and removed",
+			before: types.Providers{
+				"aws": {SeedRegions: []string{"eu-central-1", "eu-west-1"}},
+				"gcp": {SeedRegions: []string{"europe-west3"}},
+			},
+			after: types.Providers{
+				"aws":   {SeedRegions: []string{"us-east-1", "eu-central-1"}},
+				"azure": {SeedRegions: []string{"westeurope"}},
+			},
+			expectedDiff: []seeker.ProviderDiff{
+				{Provider: "aws", Added: []string{"us-east-1"}, Removed: []string{"eu-west-1"}},
+				{Provider: "azure", Added: []string{"westeurope"}},
+				{Provider: "gcp", Removed: []string{"europe-west3"}},
+			},
+			expectedFormat: `aws:
++ us-east-1
+- eu-west-1
+azure:
++ westeurope
+gcp:
+- europe-west3
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			diff := seeker.Diff(testCase.before, testCase.after)
+
+			// THEN
+			require.Equal(t, testCase.expectedDiff, diff)
+			require.Equal(t, testCase.expectedFormat, seeker.FormatDiff(diff))
+		})
+	}
+}
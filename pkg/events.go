@@ -0,0 +1,138 @@
+package seeker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "log/slog"
+
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/reference"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	EventReasonSynced            = "Synced"
+	EventReasonStoreRejected     = "StoreRejected"
+	EventReasonGardenUnreachable = "GardenUnreachable"
+	EventReasonRegionAdded       = "RegionAdded"
+	EventReasonRegionRemoved     = "RegionRemoved"
+)
+
+// Recorder records an event about the seeds cache, the event type is
+// corev1.EventTypeNormal or corev1.EventTypeWarning.
+type Recorder func(ctx context.Context, eventType, reason, message string)
+
+func Recorders(recorders ...Recorder) Recorder {
+	return func(ctx context.Context, eventType, reason, message string) {
+		for _, record := range recorders {
+			record(ctx, eventType, reason, message)
+		}
+	}
+}
+
+// ObjectSink is a sink storing the seeds cache in a Kubernetes object.
+type ObjectSink interface {
+	Sink
+	// Object returns the stored object.
+	Object(context.Context) (client.Object, error)
+}
+
+type Create func(context.Context, client.Object, ...client.CreateOption) error
+
+type EventRecorderOpts struct {
+	Timeout time.Duration
+	// ObjectSink stores the seeds cache in the object the events refer to.
+	ObjectSink
+	// Create creates the events, its client must know the kind of the
+	// object in the Scheme.
+	Create
+	Scheme *runtime.Scheme
+}
+
+// BuildEventRecorderFn builds a recorder of the events of the object the
+// sink stores the seeds cache in. The object is read for every event, so
+// the events refer to its current UID and are shown by kubectl describe.
+// The events are created before the recorder returns, so none is lost when
+// the process exits right after a sync.
+func BuildEventRecorderFn(opts EventRecorderOpts) Recorder {
+	return func(ctx context.Context, eventType, reason, message string) {
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+
+		logger := log.With("sink", opts.ObjectSink.String())
+		obj, err := opts.Object(ctx)
+		if err != nil {
+			logger.With("error", err).Warn("unable to record event")
+			return
+		}
+
+		event, err := newEvent(opts.Scheme, obj, eventType, reason, message)
+		if err != nil {
+			logger.With("error", err).Warn("unable to record event")
+			return
+		}
+
+		if err := opts.Create(ctx, event); err != nil {
+			logger.With("error", err).Warn("unable to record event")
+		}
+	}
+}
+
+// newEvent builds the event about the object the way the client-go event
+// recorders do, the events of the cluster-scoped objects are created in the
+// default namespace.
+func newEvent(scheme *runtime.Scheme, obj client.Object, eventType, reason, message string) (*corev1.Event, error) {
+	ref, err := reference.GetReference(scheme, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+
+	now := metav1.Now()
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", ref.Name, now.UnixNano()),
+			Namespace: namespace,
+		},
+		InvolvedObject: *ref,
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Source:         corev1.EventSource{Component: FieldManagerName},
+	}, nil
+}
+
+// recordStored records the regions added and removed by the store, and the
+// stored regions.
+func recordStored(ctx context.Context, record Recorder, stored Document, data types.Providers) {
+	if storedProviders, err := decodeDocument(stored); err != nil {
+		log.With("error", err).Warn("unable to decode stored document, skipping region events")
+	} else {
+		recordDiff(ctx, record, Diff(storedProviders, data))
+	}
+
+	record(ctx, corev1.EventTypeNormal, EventReasonSynced, fmt.Sprintf("%d regions of %d providers stored", data.RegionCount(), len(data)))
+}
+
+func recordDiff(ctx context.Context, record Recorder, diffs []ProviderDiff) {
+	for _, diff := range diffs {
+		for _, region := range diff.Added {
+			record(ctx, corev1.EventTypeNormal, EventReasonRegionAdded, fmt.Sprintf("provider %s: region %s added", diff.Provider, region))
+		}
+		for _, region := range diff.Removed {
+			record(ctx, corev1.EventTypeNormal, EventReasonRegionRemoved, fmt.Sprintf("provider %s: region %s removed", diff.Provider, region))
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package seeker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type testEvent struct {
+	eventType, reason, message string
+}
+
+func buildTestRecorder(events *[]testEvent) seeker.Recorder {
+	return func(_ context.Context, eventType, reason, message string) {
+		*events = append(*events, testEvent{eventType, reason, message})
+	}
+}
+
+func TestBuildStoreFn_Record(t *testing.T) {
+	testCases := []struct {
+		title          string
+		data2Store     types.Providers
+		expectedErr    error
+		expectedEvents []testEvent
+	}{
+		{
+			title: "regions added and removed",
+			data2Store: types.Providers{
+				"test": {SeedRegions: []string{"me", "more"}},
+			},
+			expectedEvents: []testEvent{
+				{corev1.EventTypeNormal, seeker.EventReasonRegionAdded, "provider test: region more added"},
+				{corev1.EventTypeNormal, seeker.EventReasonRegionRemoved, "provider test: region plz removed"},
+				{corev1.EventTypeNormal, seeker.EventReasonSynced, "2 regions of 1 providers stored"},
+			},
+		},
+		{
+			title:       "store rejected",
+			data2Store:  types.Providers{},
+			expectedErr: seeker.ErrStoreRejected,
+			expectedEvents: []testEvent{
+				{corev1.EventTypeWarning, seeker.EventReasonStoreRejected, "store rejected: all 2 regions would be removed"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.title, func(t *testing.T) {
+			// GIVEN
+			var events []testEvent
+			store := seeker.BuildStoreFn(seeker.StoreOpts{
+				Key: testKey,
+				Get: func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					*obj.(*corev1.ConfigMap) = testCM
+					return nil
+				},
+				Patch:   buildPatch(testName, testNamespace, nil),
+				Convert: seeker.ToConfigMap,
+				Guard:   seeker.BuildShrinkGuardFn(seeker.ShrinkGuardOpts{MaxShrinkPercent: 50}),
+				Record:  buildTestRecorder(&events),
+			})
+
+			// WHEN
+			err := store(context.Background(), testCase.data2Store)
+
+			// THEN
+			require.ErrorIs(t, err, testCase.expectedErr)
+			require.Equal(t, testCase.expectedEvents, events)
+		})
+	}
+}
+
+func TestBuildFetchSeedFn_Record(t *testing.T) {
+	testCases := []struct {
+		title          string
+		garden         string
+		expectedEvents []testEvent
+	}{
+		{
+			title:  "named garden",
+			garden: "test-garden",
+			expectedEvents: []testEvent{
+				{corev1.EventTypeWarning, seeker.EventReasonGardenUnreachable, "garden test-garden: connection refused"},
+			},
+		},
+		{
+			title: "garden without a name",
+			expectedEvents: []testEvent{
+				{corev1.EventTypeWarning, seeker.EventReasonGardenUnreachable, "connection refused"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.title, func(t *testing.T) {
+			// GIVEN
+			var events []testEvent
+			fetch := seeker.BuildFetchSeedFn(seeker.FetchSeedsOpts{
+				Garden: testCase.garden,
+				List: func(context.Context, client.ObjectList, ...client.ListOption) error {
+					return fmt.Errorf("connection refused")
+				},
+				Record: buildTestRecorder(&events),
+			})
+
+			// WHEN
+			_, err := fetch(context.Background())
+
+			// THEN
+			require.Error(t, err)
+			require.Equal(t, testCase.expectedEvents, events)
+		})
+	}
+}
+
+func TestBuildEventRecorderFn(t *testing.T) {
+	// GIVEN
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: testName, Namespace: testNamespace, UID: "test-uid"},
+	}).Build()
+
+	recorder := seeker.BuildEventRecorderFn(seeker.EventRecorderOpts{
+		ObjectSink: seeker.ConfigMapSink{Key: testKey, Get: c.Get},
+		Create:     c.Create,
+		Scheme:     scheme,
+		Timeout:    time.Second,
+	})
+
+	// WHEN
+	recorder(context.Background(), corev1.EventTypeNormal, seeker.EventReasonSynced, "test")
+
+	// THEN
+	var events corev1.EventList
+	require.NoError(t, c.List(context.Background(), &events, client.InNamespace(testNamespace)))
+	require.Len(t, events.Items, 1)
+	require.Equal(t, corev1.EventTypeNormal, events.Items[0].Type)
+	require.Equal(t, seeker.EventReasonSynced, events.Items[0].Reason)
+	require.Equal(t, "test", events.Items[0].Message)
+	require.Equal(t, "ConfigMap", events.Items[0].InvolvedObject.Kind)
+	require.Equal(t, testName, events.Items[0].InvolvedObject.Name)
+	require.EqualValues(t, "test-uid", events.Items[0].InvolvedObject.UID)
+}
@@ -2,33 +2,146 @@ package seeker
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	log "log/slog"
+
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/gardener-syncer/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type List func(context.Context, client.ObjectList, ...client.ListOption) error
 
-type FetchSeeds func() (types.Providers, error)
+type FetchSeeds func(context.Context) (types.Providers, error)
+
+// Selectors scope the listed seeds. Nil selectors select everything.
+type Selectors struct {
+	Label labels.Selector
+	Field fields.Selector
+}
+
+func ParseSelectors(label, field string) (Selectors, error) {
+	var out Selectors
+	var err error
+	if label != "" {
+		if out.Label, err = labels.Parse(label); err != nil {
+			return Selectors{}, err
+		}
+	}
+
+	if field != "" {
+		if out.Field, err = fields.ParseSelector(field); err != nil {
+			return Selectors{}, err
+		}
+	}
+
+	return out, nil
+}
+
+func (s Selectors) ListOptions() []client.ListOption {
+	var out []client.ListOption
+	if s.Label != nil {
+		out = append(out, client.MatchingLabelsSelector{Selector: s.Label})
+	}
+
+	if s.Field != nil {
+		out = append(out, client.MatchingFieldsSelector{Selector: s.Field})
+	}
+
+	return out
+}
 
 type FetchSeedsOpts struct {
+	// Garden is optional, it is the name of the garden the seeds are listed
+	// from, recorded as the origin of the seed regions.
+	Garden string
+	// Timeout limits listing of a single page of seeds.
 	Timeout time.Duration
+	// PageSize is optional, it limits the number of seeds listed at once.
+	PageSize int64
+	Selectors
+	// Detailed enables the seed details in the fetched provider regions.
+	Detailed bool
+	// Evaluate is optional, it defaults to the evaluation with the DefaultPolicy.
+	Evaluate
 	List
+	// Report is optional, it receives the verdicts of all the listed seeds.
+	Report
+	// Record is optional, it records the garden the seeds can not be listed
+	// from.
+	Record Recorder
+}
+
+// unreachableMessage names the garden if it has a name, the single garden
+// configured with the flags has none.
+func unreachableMessage(garden string, err error) string {
+	if garden == "" {
+		return err.Error()
+	}
+	return fmt.Sprintf("garden %s: %s", garden, err)
 }
 
 func BuildFetchSeedFn(opts FetchSeedsOpts) FetchSeeds {
-	return func() (types.Providers, error) {
-		ctx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
-		defer logWithDuration(time.Now())
+	evaluate := opts.Evaluate
+	if evaluate == nil {
+		evaluate = EvaluateSeed
+	}
+
+	listPage := func(ctx context.Context, continueToken string) (gardener_types.SeedList, error) {
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
 		defer cancel()
 
+		listOptions := opts.Selectors.ListOptions()
+		if opts.PageSize > 0 {
+			listOptions = append(listOptions, client.Limit(opts.PageSize), client.Continue(continueToken))
+		}
+
 		var seeds gardener_types.SeedList
-		if err := opts.List(ctx, &seeds); err != nil {
-			return nil, err
+		err := opts.List(ctx, &seeds, listOptions...)
+		return seeds, err
+	}
+
+	return func(ctx context.Context) (types.Providers, error) {
+		defer logWithDuration(time.Now())
+
+		// only the verdicts of the listed pages are kept, not the seeds
+		var verdicts []SeedVerdict
+		var continueToken string
+		for page := 1; ; page++ {
+			seeds, err := listPage(ctx, continueToken)
+			if err != nil {
+				if opts.Record != nil && !canceled(ctx) {
+					opts.Record(reportContext(ctx), corev1.EventTypeWarning, EventReasonGardenUnreachable, unreachableMessage(opts.Garden, err))
+				}
+				return nil, err
+			}
+
+			for _, verdict := range EvaluateSeedsWith(evaluate, seeds.Items) {
+				verdict.Garden = opts.Garden
+				verdicts = append(verdicts, verdict)
+			}
+			continueToken = seeds.Continue
+			log.With("garden", opts.Garden, "page", page, "seeds", len(seeds.Items)).Debug("seeds listed")
+
+			if continueToken == "" {
+				break
+			}
+		}
+
+		logVerdicts(verdicts)
+		if opts.Report != nil {
+			opts.Report(ctx, verdicts)
+		}
+
+		if opts.Detailed {
+			return VerdictsToDetailedProviderRegions(verdicts), nil
 		}
 
-		return ToProviderRegions(seeds.Items), nil
+		return VerdictsToProviderRegions(verdicts), nil
 	}
 }
@@ -3,6 +3,8 @@ package seeker_test
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"testing"
 
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -61,12 +63,16 @@ func TestBuildFet(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			// GIVEN
+			var verdicts []seeker.SeedVerdict
 			fetchSeeds := seeker.BuildFetchSeedFn(seeker.FetchSeedsOpts{
 				List: testCase.list,
+				Report: func(_ context.Context, reported []seeker.SeedVerdict) {
+					verdicts = reported
+				},
 			})
 
 			// WHEN
-			actual, err := fetchSeeds()
+			actual, err := fetchSeeds(context.Background())
 
 			// THEN
 			if testCase.expectedErr != nil {
@@ -77,6 +83,7 @@ func TestBuildFet(t *testing.T) {
 			// THEN
 			require.NoError(t, err)
 			require.Equal(t, testCase.expected, actual)
+			require.Equal(t, actual, seeker.VerdictsToProviderRegions(verdicts))
 		})
 	}
 }
@@ -94,3 +101,135 @@ func buildList(out gardener_types.SeedList) seeker.List {
 		return nil
 	}
 }
+
+func TestBuildFetchSeedFn_Selectors(t *testing.T) {
+	// GIVEN
+	selectors, err := seeker.ParseSelectors("seed.gardener.cloud/kyma=true", "metadata.name=test-seed")
+	require.NoError(t, err)
+
+	var actual client.ListOptions
+	fetchSeeds := seeker.BuildFetchSeedFn(seeker.FetchSeedsOpts{
+		Selectors: selectors,
+		List: func(_ context.Context, _ client.ObjectList, opts ...client.ListOption) error {
+			actual.ApplyOptions(opts)
+			return nil
+		},
+	})
+
+	// WHEN
+	_, err = fetchSeeds(context.Background())
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "seed.gardener.cloud/kyma=true", actual.LabelSelector.String())
+	require.Equal(t, "metadata.name=test-seed", actual.FieldSelector.String())
+}
+
+func TestParseSelectors(t *testing.T) {
+	testCases := []struct {
+		name        string
+		label       string
+		field       string
+		expectedErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "OK",
+			label: "seed.gardener.cloud/kyma=true",
+			field: "metadata.name!=test-seed",
+		},
+		{
+			name:        "invalid label selector",
+			label:       "seed.gardener.cloud/kyma in (true",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid field selector",
+			field:       "metadata.name",
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual, err := seeker.ParseSelectors(testCase.label, testCase.field)
+
+			// THEN
+			if testCase.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			// THEN
+			require.NoError(t, err)
+			require.Len(t, actual.ListOptions(), len(slices.DeleteFunc([]string{testCase.label, testCase.field}, func(s string) bool {
+				return s == ""
+			})))
+		})
+	}
+}
+
+func buildPagedList(pages ...[]gardener_types.Seed) (seeker.List, *[]client.ListOptions) {
+	var calls []client.ListOptions
+	return func(_ context.Context, ol client.ObjectList, opts ...client.ListOption) error {
+		var listOptions client.ListOptions
+		listOptions.ApplyOptions(opts)
+		calls = append(calls, listOptions)
+
+		page := 0
+		if listOptions.Continue != "" {
+			page, _ = strconv.Atoi(listOptions.Continue)
+		}
+
+		seedList := ol.(*gardener_types.SeedList)
+		seedList.Items = pages[page]
+		if page+1 < len(pages) {
+			seedList.Continue = strconv.Itoa(page + 1)
+		}
+		return nil
+	}, &calls
+}
+
+func TestBuildFetchSeedFn_Pages(t *testing.T) {
+	// GIVEN
+	list, calls := buildPagedList(
+		[]gardener_types.Seed{testSeedOK, testSeedInDeletion},
+		[]gardener_types.Seed{testSeedNotVisible, testSeedOKWithBackup},
+		[]gardener_types.Seed{testSeedOK},
+	)
+
+	var verdicts []seeker.SeedVerdict
+	fetchSeeds := seeker.BuildFetchSeedFn(seeker.FetchSeedsOpts{
+		PageSize: 2,
+		List:     list,
+		Report: func(_ context.Context, reported []seeker.SeedVerdict) {
+			verdicts = reported
+		},
+	})
+
+	// WHEN
+	actual, err := fetchSeeds(context.Background())
+
+	// THEN
+	require.NoError(t, err)
+	require.Len(t, verdicts, 5)
+	require.Equal(t, types.Providers{
+		testSeedOK.Spec.Provider.Type: {
+			SeedRegions: []string{testSeedOK.Spec.Provider.Region},
+		},
+		testSeedOKWithBackup.Spec.Provider.Type: {
+			SeedRegions: []string{testSeedOKWithBackup.Spec.Provider.Region},
+		},
+	}, actual)
+
+	require.Len(t, *calls, 3)
+	for i, call := range *calls {
+		require.Equal(t, int64(2), call.Limit)
+		if i > 0 {
+			require.Equal(t, strconv.Itoa(i), call.Continue)
+		}
+	}
+}
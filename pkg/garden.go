@@ -0,0 +1,81 @@
+package seeker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	log "log/slog"
+
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+var ErrAllGardensFailed = fmt.Errorf("unable to fetch seeds from any garden")
+
+type MultiGardenFetchOpts struct {
+	// Gardens are the fetch options of every garden, the garden name is
+	// required and the report is ignored.
+	Gardens []FetchSeedsOpts
+	// Report is optional, it receives the verdicts of the seeds listed in
+	// all the reachable gardens.
+	Report
+}
+
+type gardenResult struct {
+	providers types.Providers
+	verdicts  []SeedVerdict
+	err       error
+}
+
+// BuildMultiGardenFetchFn builds a function that fetches the seeds from all
+// the gardens concurrently and merges them. An unreachable garden does not
+// fail the fetch as long as any other garden is reachable, the regions of
+// the other gardens are still returned. The regions are merged, the garden
+// a seed comes from is recorded only in the seed details of SchemaV2 and in
+// the verdicts. The fetch can be called concurrently.
+func BuildMultiGardenFetchFn(opts MultiGardenFetchOpts) FetchSeeds {
+	return func(ctx context.Context) (types.Providers, error) {
+		results := make([]gardenResult, len(opts.Gardens))
+
+		var wg sync.WaitGroup
+		for i, gardenOpts := range opts.Gardens {
+			gardenOpts.Report = func(_ context.Context, verdicts []SeedVerdict) {
+				results[i].verdicts = verdicts
+			}
+			fetch := BuildFetchSeedFn(gardenOpts)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i].providers, results[i].err = fetch(ctx)
+			}()
+		}
+		wg.Wait()
+
+		out := types.Providers{}
+		var verdicts []SeedVerdict
+		var errs []error
+		for i, result := range results {
+			garden := opts.Gardens[i].Garden
+			if result.err != nil {
+				log.With("garden", garden, "error", result.err).Warn("garden unreachable")
+				errs = append(errs, fmt.Errorf("garden %s: %w", garden, result.err))
+				continue
+			}
+
+			out.Merge(result.providers)
+			verdicts = append(verdicts, result.verdicts...)
+		}
+
+		if len(errs) == len(results) {
+			return nil, errors.Join(append([]error{ErrAllGardensFailed}, errs...)...)
+		}
+
+		if opts.Report != nil {
+			opts.Report(ctx, verdicts)
+		}
+
+		return out, nil
+	}
+}
@@ -0,0 +1,155 @@
+package seeker_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMultiGardenFetchFn(t *testing.T) {
+	testCases := []struct {
+		name             string
+		gardens          []seeker.FetchSeedsOpts
+		expected         types.Providers
+		expectedVerdicts []string
+		expectedErr      error
+	}{
+		{
+			name: "all gardens reachable",
+			gardens: []seeker.FetchSeedsOpts{
+				{
+					Garden:   "test-garden1",
+					Detailed: true,
+					List:     buildList(gardener_types.SeedList{Items: []gardener_types.Seed{testSeedOK}}),
+				},
+				{
+					Garden:   "test-garden2",
+					Detailed: true,
+					List:     buildList(gardener_types.SeedList{Items: []gardener_types.Seed{testSeedOK, testSeedInDeletion}}),
+				},
+			},
+			expected: types.Providers{
+				testSeedOK.Spec.Provider.Type: {
+					SeedRegions: []string{testSeedOK.Spec.Provider.Region},
+					Regions: map[string]types.RegionInfo{
+						testSeedOK.Spec.Provider.Region: {
+							SeedCount: 2,
+							Gardens:   []string{"test-garden1", "test-garden2"},
+							Seeds: []types.SeedInfo{
+								{Garden: "test-garden1"},
+								{Garden: "test-garden2"},
+							},
+						},
+					},
+				},
+			},
+			expectedVerdicts: []string{"test-garden1", "test-garden2", "test-garden2"},
+		},
+		{
+			name: "one garden unreachable",
+			gardens: []seeker.FetchSeedsOpts{
+				{
+					Garden: "test-garden1",
+					List:   buildListWithError(errListFailedTest),
+				},
+				{
+					Garden: "test-garden2",
+					List:   buildList(gardener_types.SeedList{Items: []gardener_types.Seed{testSeedOKWithBackup}}),
+				},
+			},
+			expected: types.Providers{
+				testSeedOKWithBackup.Spec.Provider.Type: {
+					SeedRegions: []string{testSeedOKWithBackup.Spec.Provider.Region},
+				},
+			},
+			expectedVerdicts: []string{"test-garden2"},
+		},
+		{
+			name: "all gardens unreachable",
+			gardens: []seeker.FetchSeedsOpts{
+				{
+					Garden: "test-garden1",
+					List:   buildListWithError(errListFailedTest),
+				},
+				{
+					Garden: "test-garden2",
+					List:   buildListWithError(errListFailedTest),
+				},
+			},
+			expectedErr: seeker.ErrAllGardensFailed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			var verdicts []seeker.SeedVerdict
+			fetch := seeker.BuildMultiGardenFetchFn(seeker.MultiGardenFetchOpts{
+				Gardens: testCase.gardens,
+				Report: func(_ context.Context, reported []seeker.SeedVerdict) {
+					verdicts = reported
+				},
+			})
+
+			// WHEN
+			actual, err := fetch(context.Background())
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				require.ErrorIs(t, err, errListFailedTest)
+				return
+			}
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+
+			var gardens []string
+			for _, verdict := range verdicts {
+				gardens = append(gardens, verdict.Garden)
+			}
+			require.Equal(t, testCase.expectedVerdicts, gardens)
+		})
+	}
+}
+
+func TestBuildMultiGardenFetchFn_Concurrent(t *testing.T) {
+	// GIVEN
+	fetch := seeker.BuildMultiGardenFetchFn(seeker.MultiGardenFetchOpts{
+		Gardens: []seeker.FetchSeedsOpts{
+			{
+				Garden: "test-garden1",
+				List:   buildList(gardener_types.SeedList{Items: []gardener_types.Seed{testSeedOK}}),
+			},
+			{
+				Garden: "test-garden2",
+				List:   buildList(gardener_types.SeedList{Items: []gardener_types.Seed{testSeedOKWithBackup}}),
+			},
+		},
+	})
+	expected, err := fetch(context.Background())
+	require.NoError(t, err)
+
+	// WHEN
+	results := make([]types.Providers, 10)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = fetch(context.Background())
+		}()
+	}
+	wg.Wait()
+
+	// THEN
+	for _, actual := range results {
+		require.Equal(t, expected, actual)
+	}
+}
@@ -0,0 +1,77 @@
+package seeker
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	log "log/slog"
+
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+// AllowShrinkAnnotation set to "true" on the stored document disables the
+// shrink guard until the annotation is removed.
+const AllowShrinkAnnotation = "gardener-syncer.kyma-project.io/allow-shrink"
+
+var ErrStoreRejected = fmt.Errorf("store rejected")
+
+// Guard decides if the data can replace the document that is stored.
+type Guard func(stored Document, data types.Providers) error
+
+type ShrinkGuardOpts struct {
+	// MaxShrinkPercent is the largest drop of the number of regions, in
+	// percent of the stored regions, that is stored without the override.
+	MaxShrinkPercent float64
+	// AllowShrink disables the guard.
+	AllowShrink bool
+}
+
+// BuildShrinkGuardFn builds a guard that rejects the data when the number of
+// regions drops to zero or by more than the allowed percentage, or any
+// stored provider loses all its regions. A transient failure of the seeds
+// must not make all the regions of a provider unavailable, so a large drop
+// has to be allowed explicitly, with the option or the AllowShrinkAnnotation
+// on the stored document.
+func BuildShrinkGuardFn(opts ShrinkGuardOpts) Guard {
+	return func(stored Document, data types.Providers) error {
+		if opts.AllowShrink || stored.Annotations[AllowShrinkAnnotation] == "true" {
+			return nil
+		}
+
+		if len(stored.Data) == 0 {
+			return nil
+		}
+
+		storedProviders, _, err := types.Decode(stored.Data)
+		if err != nil {
+			log.With("error", err).Warn("unable to decode stored document, skipping shrink guard")
+			return nil
+		}
+
+		before := storedProviders.RegionCount()
+		after := data.RegionCount()
+		if before > 0 && after == 0 {
+			return fmt.Errorf("%w: all %d regions would be removed", ErrStoreRejected, before)
+		}
+
+		for _, provider := range slices.Sorted(maps.Keys(storedProviders)) {
+			storedRegions := len(storedProviders[provider].SeedRegions)
+			if storedRegions > 0 && len(data[provider].SeedRegions) == 0 {
+				return fmt.Errorf("%w: all %d regions of provider %s would be removed", ErrStoreRejected, storedRegions, provider)
+			}
+		}
+
+		if before == 0 || after >= before {
+			return nil
+		}
+
+		shrink := float64(before-after) / float64(before) * 100
+		if shrink > opts.MaxShrinkPercent {
+			return fmt.Errorf("%w: regions would drop from %d to %d (%.0f%%), more than allowed %.0f%%",
+				ErrStoreRejected, before, after, shrink, opts.MaxShrinkPercent)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+package seeker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var testStoredDoc = seeker.Document{
+	Data: map[string]string{
+		"aws": `seedRegions:
+- eu-central-1
+- eu-west-1`,
+		"gcp": `seedRegions:
+- europe-west3
+- us-central1`,
+	},
+}
+
+func TestBuildShrinkGuardFn(t *testing.T) {
+	allowShrinkDoc := seeker.Document{
+		Annotations: map[string]string{seeker.AllowShrinkAnnotation: "true"},
+		Data:        testStoredDoc.Data,
+	}
+
+	testCases := []struct {
+		name        string
+		opts        seeker.ShrinkGuardOpts
+		stored      seeker.Document
+		data        types.Providers
+		expectedErr error
+	}{
+		{
+			name:   "OK: nothing stored",
+			opts:   seeker.ShrinkGuardOpts{MaxShrinkPercent: 50},
+			stored: seeker.Document{},
+			data:   types.Providers{},
+		},
+		{
+			name:   "OK: regions added",
+			opts:   seeker.ShrinkGuardOpts{MaxShrinkPercent: 50},
+			stored: testStoredDoc,
+			data: types.Providers{
+				"aws": {SeedRegions: []string{"eu-central-1", "eu-west-1"}},
+				"gcp": {SeedRegions: []string{"europe-west3", "us-central1", "asia-south1"}},
+			},
+		},
+		{
+			name:   "OK: shrink within the limit",
+			opts:   seeker.ShrinkGuardOpts{MaxShrinkPercent: 50},
+			stored: testStoredDoc,
+			data: types.Providers{
+				"aws": {SeedRegions: []string{"eu-central-1"}},
+				"gcp": {SeedRegions: []string{"europe-west3"}},
+			},
+		},
+		{
+			name:   "OK: shrink allowed by the option",
+			opts:   seeker.ShrinkGuardOpts{MaxShrinkPercent: 50, AllowShrink: true},
+			stored: testStoredDoc,
+			data:   types.Providers{},
+		},
+		{
+			name:   "OK: shrink allowed by the annotation",
+			opts:   seeker.ShrinkGuardOpts{MaxShrinkPercent: 50},
+			stored: allowShrinkDoc,
+			data:   types.Providers{},
+		},
+		{
+			name:   "ERR: shrink over the limit",
+			opts:   seeker.ShrinkGuardOpts{MaxShrinkPercent: 50},
+			stored: testStoredDoc,
+			data: types.Providers{
+				"aws": {SeedRegions: []string{"eu-central-1"}},
+			},
+			expectedErr: seeker.ErrStoreRejected,
+		},
+		{
+			name:   "ERR: all regions of a provider removed",
+			opts:   seeker.ShrinkGuardOpts{MaxShrinkPercent: 50},
+			stored: testStoredDoc,
+			data: types.Providers{
+				"gcp": {SeedRegions: []string{"europe-west3", "us-central1", "asia-south1", "asia-east1"}},
+			},
+			expectedErr: fmt.Errorf("%w: all 2 regions of provider aws would be removed", seeker.ErrStoreRejected),
+		},
+		{
+			name:        "ERR: all regions removed",
+			opts:        seeker.ShrinkGuardOpts{MaxShrinkPercent: 100},
+			stored:      testStoredDoc,
+			data:        types.Providers{},
+			expectedErr: seeker.ErrStoreRejected,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			guard := seeker.BuildShrinkGuardFn(testCase.opts)
+
+			// WHEN
+			err := guard(testCase.stored, testCase.data)
+
+			// THEN
+			if testCase.expectedErr == nil {
+				require.NoError(t, err)
+			}
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, seeker.ErrStoreRejected)
+				require.ErrorContains(t, err, testCase.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestBuildStoreFn_Guard(t *testing.T) {
+	// GIVEN
+	stored := testCM
+	stored.ObjectMeta = metav1.ObjectMeta{Name: testName, Namespace: testNamespace}
+	var patched bool
+	store := seeker.BuildStoreFn(seeker.StoreOpts{
+		Key: client.ObjectKey{Name: testName, Namespace: testNamespace},
+		Get: func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+			*obj.(*corev1.ConfigMap) = stored
+			return nil
+		},
+		Patch: func(context.Context, client.Object, client.Patch, ...client.PatchOption) error {
+			patched = true
+			return nil
+		},
+		Convert: seeker.ToConfigMap,
+		Guard:   seeker.BuildShrinkGuardFn(seeker.ShrinkGuardOpts{MaxShrinkPercent: 50}),
+	})
+
+	// WHEN
+	err := store(context.Background(), types.Providers{})
+
+	// THEN
+	require.ErrorIs(t, err, seeker.ErrStoreRejected)
+	require.False(t, patched)
+}
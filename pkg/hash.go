@@ -0,0 +1,62 @@
+package seeker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"slices"
+	"time"
+)
+
+const (
+	// ContentHashAnnotation holds the ContentHash of the stored data.
+	ContentHashAnnotation = "gardener-syncer.kyma-project.io/content-hash"
+	// LastChangeAnnotation holds the time the stored data last changed.
+	LastChangeAnnotation = "gardener-syncer.kyma-project.io/last-change"
+	// LastSyncAnnotation holds the time the stored data was last written.
+	LastSyncAnnotation = "gardener-syncer.kyma-project.io/last-sync"
+)
+
+// ContentHash returns a hash of the data that does not depend on the order
+// of the keys.
+func ContentHash(data map[string]string) string {
+	hash := sha256.New()
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		// the null bytes separate the keys and values, so different data
+		// can not be concatenated into the same input
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write([]byte(data[key]))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// annotate builds the document with the converted data and the content
+// hash and timestamps describing it. The data changed if it differs from the
+// stored data, the stored hash annotation is not trusted as it is kept when
+// the data is edited by hand. The last change time is kept from the stored
+// document if the data did not change. The data was just fetched, so the
+// document is not stale.
+func annotate(stored Document, data map[string]string, now time.Time) (doc Document, changed bool) {
+	timestamp := now.UTC().Format(time.RFC3339)
+	hash := ContentHash(data)
+	changed = ContentHash(stored.Data) != hash
+
+	lastChange := stored.Annotations[LastChangeAnnotation]
+	if changed || lastChange == "" {
+		lastChange = timestamp
+	}
+
+	return Document{
+		Annotations: map[string]string{
+			ContentHashAnnotation: hash,
+			LastChangeAnnotation:  lastChange,
+			LastSyncAnnotation:    timestamp,
+			// the fetch time is the store time, the data is stored right
+			// after it is fetched
+			LastSuccessfulFetchAnnotation: timestamp,
+		},
+		Data: data,
+	}, changed
+}
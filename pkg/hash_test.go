@@ -0,0 +1,137 @@
+package seeker_test
+
+import (
+	"context"
+	"testing"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestContentHash(t *testing.T) {
+	// GIVEN
+	data := map[string]string{"a": "b", "c": "d"}
+
+	// WHEN
+	hash := seeker.ContentHash(data)
+
+	// THEN
+	require.Equal(t, hash, seeker.ContentHash(map[string]string{"c": "d", "a": "b"}))
+	require.NotEqual(t, hash, seeker.ContentHash(map[string]string{"a": "bc", "": "d"}))
+	require.NotEqual(t, hash, seeker.ContentHash(map[string]string{"a": "b"}))
+}
+
+func TestBuildStoreFn_Unchanged(t *testing.T) {
+	storedData, err := seeker.ToConfigMap(testProviderRegions)
+	require.NoError(t, err)
+
+	const lastChange = "2024-01-01T00:00:00Z"
+	stored := testCM
+	stored.Data = storedData
+	stored.Annotations = map[string]string{
+		seeker.ContentHashAnnotation: seeker.ContentHash(storedData),
+		seeker.LastChangeAnnotation:  lastChange,
+		seeker.LastSyncAnnotation:    lastChange,
+	}
+
+	testCases := []struct {
+		title              string
+		data2Store         types.Providers
+		refreshLastSync    bool
+		stale              bool
+		edited             bool
+		hashMissing        bool
+		expectedPatch      bool
+		expectedLastChange bool
+	}{
+		{
+			title:      "unchanged",
+			data2Store: testProviderRegions,
+		},
+		{
+			title:              "unchanged, last sync refreshed",
+			data2Store:         testProviderRegions,
+			refreshLastSync:    true,
+			expectedPatch:      true,
+			expectedLastChange: true,
+		},
+		{
+			title:              "unchanged, stale marker removed",
+			data2Store:         testProviderRegions,
+			stale:              true,
+			expectedPatch:      true,
+			expectedLastChange: true,
+		},
+		{
+			title:         "edited, hash annotation kept",
+			data2Store:    testProviderRegions,
+			edited:        true,
+			expectedPatch: true,
+		},
+		{
+			title:              "unchanged, hash annotation missing",
+			data2Store:         testProviderRegions,
+			hashMissing:        true,
+			expectedPatch:      true,
+			expectedLastChange: true,
+		},
+		{
+			title: "changed",
+			data2Store: types.Providers{
+				"test": {SeedRegions: []string{"me"}},
+			},
+			expectedPatch: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.title, func(t *testing.T) {
+			// GIVEN
+			stored := stored.DeepCopy()
+			if testCase.stale {
+				stored.Annotations[seeker.StaleAnnotation] = "true"
+			}
+			if testCase.edited {
+				stored.Data["test"] = "edited"
+			}
+			if testCase.hashMissing {
+				delete(stored.Annotations, seeker.ContentHashAnnotation)
+			}
+
+			var patched *corev1.ConfigMap
+			store := seeker.BuildStoreFn(seeker.StoreOpts{
+				Key: client.ObjectKey{Name: testName, Namespace: testNamespace},
+				Get: func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					*obj.(*corev1.ConfigMap) = *stored.DeepCopy()
+					return nil
+				},
+				Patch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					patched = obj.(*corev1.ConfigMap)
+					return nil
+				},
+				Convert:         seeker.ToConfigMap,
+				RefreshLastSync: testCase.refreshLastSync,
+			})
+
+			// WHEN
+			err := store(context.Background(), testCase.data2Store)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedPatch, patched != nil)
+			if patched == nil {
+				return
+			}
+
+			// THEN
+			require.Equal(t, seeker.ContentHash(patched.Data), patched.Annotations[seeker.ContentHashAnnotation])
+			require.NotEqual(t, lastChange, patched.Annotations[seeker.LastSyncAnnotation])
+			require.Equal(t, patched.Annotations[seeker.LastSyncAnnotation], patched.Annotations[seeker.LastSuccessfulFetchAnnotation])
+			require.NotContains(t, patched.Annotations, seeker.StaleAnnotation)
+			require.Equal(t, testCase.expectedLastChange, patched.Annotations[seeker.LastChangeAnnotation] == lastChange)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package seeker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "log/slog"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+var ErrTooManyFailures = fmt.Errorf("too many consecutive sync failures")
+
+type Loop func(context.Context) error
+
+type LoopOpts struct {
+	Interval               time.Duration
+	JitterFactor           float64
+	InitialBackoff         time.Duration
+	MaxBackoff             time.Duration
+	MaxConsecutiveFailures int
+	Sync
+}
+
+// exponentialBackoff returns the delay before the next attempt after the
+// given number of consecutive failures, doubling the initial backoff up to
+// the maximum.
+func exponentialBackoff(initial, maxBackoff time.Duration, failures int) time.Duration {
+	delay := initial
+	for i := 1; i < failures && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
+
+// Delay returns the jittered delay before the next sync after the given
+// number of consecutive failures, the interval if there are none. A zero
+// JitterFactor disables the jitter, the backoff is capped after the jitter.
+func (opts LoopOpts) Delay(failures int) time.Duration {
+	if failures == 0 {
+		return opts.jitter(opts.Interval)
+	}
+	return min(opts.jitter(exponentialBackoff(opts.InitialBackoff, opts.MaxBackoff, failures)), opts.MaxBackoff)
+}
+
+// jitter extends the duration randomly, wait.Jitter treats a zero factor
+// as 1.0, so it is not called then.
+func (opts LoopOpts) jitter(d time.Duration) time.Duration {
+	if opts.JitterFactor <= 0 {
+		return d
+	}
+	return wait.Jitter(d, opts.JitterFactor)
+}
+
+// BuildLoopFn builds a function that runs the sync periodically until the
+// context is done. Every sync is delayed by the jittered interval; failed
+// syncs are retried with an exponential backoff instead. The loop gives up
+// with ErrTooManyFailures when MaxConsecutiveFailures is reached; zero means
+// it never gives up.
+func BuildLoopFn(opts LoopOpts) Loop {
+	return func(ctx context.Context) error {
+		failures := 0
+		for {
+			if err := opts.Sync(ctx); errors.Is(err, ErrCanceled) {
+				log.Info(err.Error())
+				return nil
+			} else if err != nil {
+				failures++
+				log.With("consecutiveFailures", failures).Error(err.Error())
+
+				if opts.MaxConsecutiveFailures > 0 && failures >= opts.MaxConsecutiveFailures {
+					return fmt.Errorf("%w: %d: %w", ErrTooManyFailures, failures, err)
+				}
+
+			} else {
+				failures = 0
+			}
+
+			delay := opts.Delay(failures)
+			log.With("delay", delay).Info("next sync scheduled")
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(delay):
+			}
+		}
+	}
+}
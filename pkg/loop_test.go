@@ -0,0 +1,171 @@
+package seeker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+)
+
+func buildSyncWithResults(results ...error) (seeker.Sync, *int) {
+	var calls int
+	return func(context.Context) error {
+		calls++
+		if calls > len(results) {
+			return nil
+		}
+		return results[calls-1]
+	}, &calls
+}
+
+func TestBuildLoopFn(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		results                []error
+		maxConsecutiveFailures int
+		expectedErr            error
+		expectedCalls          int
+	}{
+		{
+			name:                   "too many failures",
+			results:                []error{errStoreFailedTest, errStoreFailedTest, errStoreFailedTest},
+			maxConsecutiveFailures: 3,
+			expectedErr:            seeker.ErrTooManyFailures,
+			expectedCalls:          3,
+		},
+		{
+			name:                   "failures counter reset on success",
+			results:                []error{errStoreFailedTest, errStoreFailedTest, nil, errStoreFailedTest, errStoreFailedTest, errStoreFailedTest},
+			maxConsecutiveFailures: 3,
+			expectedErr:            seeker.ErrTooManyFailures,
+			expectedCalls:          6,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			sync, calls := buildSyncWithResults(testCase.results...)
+			loop := seeker.BuildLoopFn(seeker.LoopOpts{
+				Interval:               time.Millisecond,
+				InitialBackoff:         time.Millisecond,
+				MaxBackoff:             time.Millisecond,
+				MaxConsecutiveFailures: testCase.maxConsecutiveFailures,
+				Sync:                   sync,
+			})
+
+			// WHEN
+			err := loop(context.Background())
+
+			// THEN
+			require.ErrorIs(t, err, testCase.expectedErr)
+			require.ErrorIs(t, err, errStoreFailedTest)
+			require.Equal(t, testCase.expectedCalls, *calls)
+		})
+	}
+}
+
+func TestBuildLoopFn_ContextDone(t *testing.T) {
+	// GIVEN
+	ctx, cancel := context.WithCancel(context.Background())
+	sync, calls := buildSyncWithResults()
+	loop := seeker.BuildLoopFn(seeker.LoopOpts{
+		Interval: time.Hour,
+		Sync: func(ctx context.Context) error {
+			defer cancel()
+			return sync(ctx)
+		},
+	})
+
+	// WHEN
+	err := loop(ctx)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, 1, *calls)
+}
+
+func TestBuildLoopFn_SyncCanceled(t *testing.T) {
+	// GIVEN
+	sync, calls := buildSyncWithResults(seeker.ErrCanceled)
+	loop := seeker.BuildLoopFn(seeker.LoopOpts{
+		Interval:               time.Hour,
+		MaxConsecutiveFailures: 1,
+		Sync:                   sync,
+	})
+
+	// WHEN
+	err := loop(context.Background())
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, 1, *calls)
+}
+
+func TestLoopOpts_Delay(t *testing.T) {
+	testCases := []struct {
+		name         string
+		jitterFactor float64
+		failures     int
+		expectedMin  time.Duration
+		expectedMax  time.Duration
+	}{
+		{
+			name:        "interval without jitter",
+			expectedMin: time.Minute,
+			expectedMax: time.Minute,
+		},
+		{
+			name:        "initial backoff",
+			failures:    1,
+			expectedMin: time.Second,
+			expectedMax: time.Second,
+		},
+		{
+			name:        "backoff doubled",
+			failures:    3,
+			expectedMin: 4 * time.Second,
+			expectedMax: 4 * time.Second,
+		},
+		{
+			name:        "backoff capped",
+			failures:    10,
+			expectedMin: 10 * time.Second,
+			expectedMax: 10 * time.Second,
+		},
+		{
+			name:         "jittered interval",
+			jitterFactor: 0.5,
+			expectedMin:  time.Minute,
+			expectedMax:  90 * time.Second,
+		},
+		{
+			name:         "jittered backoff capped",
+			jitterFactor: 0.5,
+			failures:     10,
+			expectedMin:  10 * time.Second,
+			expectedMax:  10 * time.Second,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			opts := seeker.LoopOpts{
+				Interval:       time.Minute,
+				JitterFactor:   testCase.jitterFactor,
+				InitialBackoff: time.Second,
+				MaxBackoff:     10 * time.Second,
+			}
+
+			// WHEN
+			delay := opts.Delay(testCase.failures)
+
+			// THEN
+			require.GreaterOrEqual(t, delay, testCase.expectedMin)
+			require.LessOrEqual(t, delay, testCase.expectedMax)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	log "log/slog"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/server"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "gardener_syncer"
+
+var (
+	FetchDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "fetch_duration_seconds",
+		Help:      "Duration of fetching gardener seeds.",
+	})
+	StoreDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "store_duration_seconds",
+		Help:      "Duration of storing the seeds cache.",
+	})
+	StoresRejected = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "stores_rejected_total",
+		Help:      "Number of stores rejected because the number of regions dropped too much.",
+	})
+	SeedsListed = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "seeds_listed_total",
+		Help:      "Number of gardener seeds listed.",
+	})
+	SeedsRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "seeds_rejected_total",
+		Help:      "Number of listed gardener seeds that can not be used, by reason.",
+	}, []string{"reason"})
+	RegionsPerProvider = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "provider_regions",
+		Help:      "Number of regions available per provider type in the last fetch.",
+	}, []string{"provider"})
+	Syncs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "syncs_total",
+		Help:      "Number of syncs, by result.",
+	}, []string{"result"})
+	LastSuccessfulSync = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "last_successful_sync_timestamp_seconds",
+		Help:      "Unix time of the last successful sync.",
+	})
+)
+
+const (
+	resultSuccess  = "success"
+	resultFailure  = "failure"
+	resultCanceled = "canceled"
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		FetchDuration,
+		StoreDuration,
+		StoresRejected,
+		SeedsListed,
+		SeedsRejected,
+		RegionsPerProvider,
+		Syncs,
+		LastSuccessfulSync,
+	)
+
+	for _, reason := range seeker.RejectionReasons {
+		SeedsRejected.WithLabelValues(string(reason))
+	}
+}
+
+func observeDuration(histogram prometheus.Histogram, start time.Time) {
+	histogram.Observe(time.Since(start).Seconds())
+}
+
+// RecordVerdicts counts the listed seeds and the reasons the listed seeds
+// are rejected for. It can be used as a seeker.Report.
+func RecordVerdicts(_ context.Context, verdicts []seeker.SeedVerdict) {
+	SeedsListed.Add(float64(len(verdicts)))
+	for _, verdict := range verdicts {
+		for _, rejection := range verdict.Rejections {
+			SeedsRejected.WithLabelValues(string(rejection.Reason)).Inc()
+		}
+	}
+}
+
+// InstrumentFetch measures the fetch duration and records the number of
+// regions per provider.
+func InstrumentFetch(fetch seeker.FetchSeeds) seeker.FetchSeeds {
+	return func(ctx context.Context) (types.Providers, error) {
+		defer observeDuration(FetchDuration, time.Now())
+
+		providers, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		RegionsPerProvider.Reset()
+		for provider, info := range providers {
+			RegionsPerProvider.WithLabelValues(provider).Set(float64(len(info.SeedRegions)))
+		}
+		return providers, nil
+	}
+}
+
+// InstrumentStore measures the store duration and counts the stores
+// rejected by the guard.
+func InstrumentStore(store seeker.Store) seeker.Store {
+	return func(ctx context.Context, providers types.Providers) error {
+		defer observeDuration(StoreDuration, time.Now())
+
+		err := store(ctx, providers)
+		if errors.Is(err, seeker.ErrStoreRejected) {
+			StoresRejected.Inc()
+		}
+		return err
+	}
+}
+
+// InstrumentSync counts the syncs and records the time of the last
+// successful one. The canceled syncs are counted apart from the failed ones.
+func InstrumentSync(sync seeker.Sync) seeker.Sync {
+	return func(ctx context.Context) error {
+		if err := sync(ctx); err != nil {
+			result := resultFailure
+			if errors.Is(err, seeker.ErrCanceled) {
+				result = resultCanceled
+			}
+			Syncs.WithLabelValues(result).Inc()
+			return err
+		}
+
+		Syncs.WithLabelValues(resultSuccess).Inc()
+		LastSuccessfulSync.SetToCurrentTime()
+		return nil
+	}
+}
+
+// Serve exposes the metrics on the /metrics path of the given address until
+// the context is done.
+func Serve(ctx context.Context, bindAddress string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(ctrlmetrics.Registry, promhttp.HandlerOpts{}))
+
+	log.With("address", bindAddress).Info("serving metrics")
+	return server.ListenAndServe(ctx, bindAddress, mux)
+}
@@ -0,0 +1,99 @@
+package metrics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/metrics"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+var errSyncFailedTest = fmt.Errorf("sync failed test")
+
+func TestInstrumentSync(t *testing.T) {
+	// GIVEN
+	failures := testutil.ToFloat64(metrics.Syncs.WithLabelValues("failure"))
+	cancellations := testutil.ToFloat64(metrics.Syncs.WithLabelValues("canceled"))
+	successes := testutil.ToFloat64(metrics.Syncs.WithLabelValues("success"))
+
+	// WHEN
+	errFailed := metrics.InstrumentSync(func(context.Context) error { return errSyncFailedTest })(context.Background())
+	errOK := metrics.InstrumentSync(func(context.Context) error { return nil })(context.Background())
+	errCanceled := metrics.InstrumentSync(func(context.Context) error { return seeker.ErrCanceled })(context.Background())
+
+	// THEN
+	require.ErrorIs(t, errFailed, errSyncFailedTest)
+	require.NoError(t, errOK)
+	require.ErrorIs(t, errCanceled, seeker.ErrCanceled)
+	require.Equal(t, cancellations+1, testutil.ToFloat64(metrics.Syncs.WithLabelValues("canceled")))
+	require.Equal(t, failures+1, testutil.ToFloat64(metrics.Syncs.WithLabelValues("failure")))
+	require.Equal(t, successes+1, testutil.ToFloat64(metrics.Syncs.WithLabelValues("success")))
+	require.NotZero(t, testutil.ToFloat64(metrics.LastSuccessfulSync))
+}
+
+func TestRecordVerdicts(t *testing.T) {
+	// GIVEN
+	listed := testutil.ToFloat64(metrics.SeedsListed)
+	deleted := testutil.ToFloat64(metrics.SeedsRejected.WithLabelValues(string(seeker.ReasonDeleted)))
+	invisible := testutil.ToFloat64(metrics.SeedsRejected.WithLabelValues(string(seeker.ReasonInvisible)))
+
+	// WHEN
+	metrics.RecordVerdicts(context.Background(), []seeker.SeedVerdict{
+		{
+			Seed: "test-seed1",
+			Rejections: []seeker.Rejection{
+				{Reason: seeker.ReasonDeleted},
+				{Reason: seeker.ReasonInvisible},
+			},
+		},
+		{
+			Seed: "test-seed2",
+			Rejections: []seeker.Rejection{
+				{Reason: seeker.ReasonInvisible},
+			},
+		},
+		{
+			Seed: "test-seed3",
+		},
+	})
+
+	// THEN
+	require.Equal(t, listed+3, testutil.ToFloat64(metrics.SeedsListed))
+	require.Equal(t, deleted+1, testutil.ToFloat64(metrics.SeedsRejected.WithLabelValues(string(seeker.ReasonDeleted))))
+	require.Equal(t, invisible+2, testutil.ToFloat64(metrics.SeedsRejected.WithLabelValues(string(seeker.ReasonInvisible))))
+}
+
+func TestInstrumentFetch(t *testing.T) {
+	// GIVEN
+	fetch := metrics.InstrumentFetch(func(context.Context) (types.Providers, error) {
+		return types.Providers{
+			"test-provider": {SeedRegions: []string{"test-region1", "test-region2"}},
+		}, nil
+	})
+
+	// WHEN
+	_, err := fetch(context.Background())
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, float64(2), testutil.ToFloat64(metrics.RegionsPerProvider.WithLabelValues("test-provider")))
+}
+
+func TestInstrumentStore(t *testing.T) {
+	// GIVEN
+	rejected := testutil.ToFloat64(metrics.StoresRejected)
+	store := metrics.InstrumentStore(func(context.Context, types.Providers) error {
+		return fmt.Errorf("%w: test", seeker.ErrStoreRejected)
+	})
+
+	// WHEN
+	err := store(context.Background(), types.Providers{})
+
+	// THEN
+	require.ErrorIs(t, err, seeker.ErrStoreRejected)
+	require.Equal(t, rejected+1, testutil.ToFloat64(metrics.StoresRejected))
+}
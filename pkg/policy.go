@@ -0,0 +1,266 @@
+package seeker
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+)
+
+// Policy declares the rules a seed has to follow to be published in the
+// seeds cache. Deleted and invisible seeds, and seeds without the last
+// operation, are never published.
+type Policy struct {
+	// RequiredConditions are the seed conditions that must be True.
+	RequiredConditions []gardener_types.ConditionType `json:"requiredConditions"`
+	// RequireBackupReady requires the BackupBucketsReady condition to be True
+	// on the seeds with backup configured.
+	RequireBackupReady bool `json:"requireBackupReady"`
+	// LabelSelector is optional, it selects the seeds that can be used.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// ToleratedTaints are the keys of the taints a seed can have. A seed with
+	// any other taint is excluded, so without any tolerated taints every
+	// tainted seed is excluded.
+	ToleratedTaints []string `json:"toleratedTaints,omitempty"`
+	// ExcludedTaints are the keys of the taints that exclude a seed, even if
+	// they are tolerated.
+	ExcludedTaints []string `json:"excludedTaints,omitempty"`
+	// MinGardenletVersion is optional, it is the minimal version of the
+	// gardenlet running in the seed.
+	MinGardenletVersion string `json:"minGardenletVersion,omitempty"`
+}
+
+// DefaultPolicy is the policy used when no policy file is configured. It
+// tolerates no taints, so every tainted seed is excluded.
+var DefaultPolicy = Policy{
+	RequiredConditions: []gardener_types.ConditionType{
+		gardener_types.SeedGardenletReady,
+	},
+	RequireBackupReady: true,
+}
+
+var ErrInvalidPolicy = fmt.Errorf("invalid policy")
+
+// LoadPolicy reads the policy from a YAML file. The fields missing in the
+// file keep the values of the DefaultPolicy.
+func LoadPolicy(path string) (Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	out := DefaultPolicy
+	out.RequiredConditions = slices.Clone(DefaultPolicy.RequiredConditions)
+	if err := yaml.UnmarshalStrict(data, &out); err != nil {
+		return Policy{}, fmt.Errorf("%w: %w", ErrInvalidPolicy, err)
+	}
+
+	if _, err := out.Evaluator(); err != nil {
+		return Policy{}, err
+	}
+
+	return out, nil
+}
+
+// Evaluate returns the verdict of the seed.
+type Evaluate func(*gardener_types.Seed) SeedVerdict
+
+// Evaluator compiles the policy into the function evaluating seeds.
+func (p Policy) Evaluator() (Evaluate, error) {
+	selector := labels.Everything()
+	if p.LabelSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(p.LabelSelector); err != nil {
+			return nil, fmt.Errorf("%w: label selector: %w", ErrInvalidPolicy, err)
+		}
+	}
+
+	var minGardenletVersion *semver.Version
+	if p.MinGardenletVersion != "" {
+		var err error
+		if minGardenletVersion, err = semver.NewVersion(p.MinGardenletVersion); err != nil {
+			return nil, fmt.Errorf("%w: min gardenlet version: %w", ErrInvalidPolicy, err)
+		}
+	}
+
+	checks := []func(*gardener_types.Seed) []Rejection{
+		checkDeletion,
+		checkVisibility,
+		checkLastOperation,
+		checkConditions(p.RequiredConditions),
+		checkTaints(p.ToleratedTaints, p.ExcludedTaints),
+	}
+
+	if p.RequireBackupReady {
+		checks = append(checks, checkBackup)
+	}
+
+	if !selector.Empty() {
+		checks = append(checks, checkLabels(selector))
+	}
+
+	if minGardenletVersion != nil {
+		checks = append(checks, checkGardenletVersion(minGardenletVersion))
+	}
+
+	return func(seed *gardener_types.Seed) SeedVerdict {
+		out := SeedVerdict{
+			Seed:     seed.Name,
+			Provider: seed.Spec.Provider.Type,
+			Region:   seed.Spec.Provider.Region,
+			Details:  toSeedInfo(seed),
+		}
+
+		for _, check := range checks {
+			out.Rejections = append(out.Rejections, check(seed)...)
+		}
+		return out
+	}, nil
+}
+
+func checkDeletion(seed *gardener_types.Seed) []Rejection {
+	if seed.DeletionTimestamp == nil {
+		return nil
+	}
+
+	return []Rejection{{
+		Reason:  ReasonDeleted,
+		Message: fmt.Sprintf("deletion timestamp set to %s", seed.DeletionTimestamp),
+	}}
+}
+
+func checkVisibility(seed *gardener_types.Seed) []Rejection {
+	if seed.Spec.Settings != nil && seed.Spec.Settings.Scheduling != nil && seed.Spec.Settings.Scheduling.Visible {
+		return nil
+	}
+
+	return []Rejection{{
+		Reason:  ReasonInvisible,
+		Message: "scheduling visibility disabled",
+	}}
+}
+
+func checkLastOperation(seed *gardener_types.Seed) []Rejection {
+	if seed.Status.LastOperation != nil {
+		return nil
+	}
+
+	return []Rejection{{
+		Reason:  ReasonNoLastOperation,
+		Message: "last operation not found",
+	}}
+}
+
+func conditionReason(conditionType gardener_types.ConditionType) RejectionReason {
+	switch conditionType {
+	case gardener_types.SeedGardenletReady:
+		return ReasonGardenletNotReady
+	case gardener_types.SeedBackupBucketsReady:
+		return ReasonBackupNotReady
+	}
+	return ReasonConditionNotReady
+}
+
+func checkConditions(conditionTypes []gardener_types.ConditionType) func(*gardener_types.Seed) []Rejection {
+	return func(seed *gardener_types.Seed) []Rejection {
+		var out []Rejection
+		for _, conditionType := range conditionTypes {
+			if rejection := conditionRejection(seed, conditionType, conditionReason(conditionType)); rejection != nil {
+				out = append(out, *rejection)
+			}
+		}
+		return out
+	}
+}
+
+func checkBackup(seed *gardener_types.Seed) []Rejection {
+	if seed.Spec.Backup == nil {
+		return nil
+	}
+
+	return checkConditions([]gardener_types.ConditionType{gardener_types.SeedBackupBucketsReady})(seed)
+}
+
+func checkLabels(selector labels.Selector) func(*gardener_types.Seed) []Rejection {
+	return func(seed *gardener_types.Seed) []Rejection {
+		if selector.Matches(labels.Set(seed.Labels)) {
+			return nil
+		}
+
+		return []Rejection{{
+			Reason:  ReasonLabelMismatch,
+			Message: fmt.Sprintf("labels do not match selector %s", selector),
+		}}
+	}
+}
+
+func taintString(taint gardener_types.SeedTaint) string {
+	if taint.Value == nil {
+		return taint.Key
+	}
+	return fmt.Sprintf("%s=%s", taint.Key, *taint.Value)
+}
+
+func checkTaints(tolerated, excluded []string) func(*gardener_types.Seed) []Rejection {
+	return func(seed *gardener_types.Seed) []Rejection {
+		var out []Rejection
+		for _, taint := range seed.Spec.Taints {
+			message := fmt.Sprintf("taint %s is not tolerated", taintString(taint))
+			if slices.Contains(excluded, taint.Key) {
+				message = fmt.Sprintf("taint %s is excluded", taintString(taint))
+			} else if slices.Contains(tolerated, taint.Key) {
+				continue
+			}
+
+			out = append(out, Rejection{
+				Reason:  ReasonTainted,
+				Message: message,
+				Taint:   taint.Key,
+			})
+		}
+		return out
+	}
+}
+
+// Tolerate adds the taint keys to the tolerated taints of the policy.
+func (p Policy) Tolerate(taintKeys ...string) Policy {
+	p.ToleratedTaints = slices.Clone(p.ToleratedTaints)
+	for _, key := range taintKeys {
+		if !slices.Contains(p.ToleratedTaints, key) {
+			p.ToleratedTaints = append(p.ToleratedTaints, key)
+		}
+	}
+	return p
+}
+
+func checkGardenletVersion(minVersion *semver.Version) func(*gardener_types.Seed) []Rejection {
+	return func(seed *gardener_types.Seed) []Rejection {
+		if seed.Status.Gardener == nil {
+			return []Rejection{{
+				Reason:  ReasonGardenletOutdated,
+				Message: "gardenlet version unknown",
+			}}
+		}
+
+		version, err := semver.NewVersion(seed.Status.Gardener.Version)
+		if err != nil {
+			return []Rejection{{
+				Reason:  ReasonGardenletOutdated,
+				Message: fmt.Sprintf("invalid gardenlet version %s: %s", seed.Status.Gardener.Version, err),
+			}}
+		}
+
+		if version.LessThan(minVersion) {
+			return []Rejection{{
+				Reason:  ReasonGardenletOutdated,
+				Message: fmt.Sprintf("gardenlet version %s is lower than %s", version, minVersion),
+			}}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,256 @@
+package seeker_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func writePolicy(t *testing.T, data string) string {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	return path
+}
+
+func TestLoadPolicy(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        string
+		expected    seeker.Policy
+		expectedErr error
+	}{
+		{
+			name:     "empty",
+			data:     "",
+			expected: seeker.DefaultPolicy,
+		},
+		{
+			name: "overrides",
+			data: `requiredConditions:
+- GardenletReady
+- ExtensionsReady
+requireBackupReady: false
+labelSelector:
+  matchLabels:
+    seed.gardener.cloud/kyma: "true"
+toleratedTaints:
+- seed.gardener.cloud/protected
+excludedTaints:
+- seed.gardener.cloud/invisible
+minGardenletVersion: v1.100.0`,
+			expected: seeker.Policy{
+				RequiredConditions: []gardener_types.ConditionType{
+					gardener_types.SeedGardenletReady,
+					gardener_types.SeedExtensionsReady,
+				},
+				RequireBackupReady: false,
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"seed.gardener.cloud/kyma": "true"},
+				},
+				ToleratedTaints:     []string{"seed.gardener.cloud/protected"},
+				ExcludedTaints:      []string{"seed.gardener.cloud/invisible"},
+				MinGardenletVersion: "v1.100.0",
+			},
+		},
+		{
+			name:        "unknown field",
+			data:        "unknown: true",
+			expectedErr: seeker.ErrInvalidPolicy,
+		},
+		{
+			name:        "invalid min gardenlet version",
+			data:        "minGardenletVersion: latest",
+			expectedErr: seeker.ErrInvalidPolicy,
+		},
+		{
+			name: "invalid label selector",
+			data: `labelSelector:
+  matchExpressions:
+  - key: test
+    operator: Invalid`,
+			expectedErr: seeker.ErrInvalidPolicy,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			path := writePolicy(t, testCase.data)
+
+			// WHEN
+			actual, err := seeker.LoadPolicy(path)
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestPolicy_Evaluator(t *testing.T) {
+	testSeedExtensionsNotReady := testSeedOK.DeepCopy()
+	testSeedExtensionsNotReady.Status.Conditions = append(testSeedExtensionsNotReady.Status.Conditions, gardener_types.Condition{
+		Type:   gardener_types.SeedExtensionsReady,
+		Status: gardener_types.ConditionFalse,
+	})
+
+	testSeedLabeled := testSeedOK.DeepCopy()
+	testSeedLabeled.Labels = map[string]string{"seed.gardener.cloud/kyma": "true"}
+
+	testSeedTainted := testSeedOK.DeepCopy()
+	testSeedTainted.Spec.Taints = []gardener_types.SeedTaint{
+		{Key: "seed.gardener.cloud/protected"},
+	}
+
+	testSeedGardenletVersion := func(version string) *gardener_types.Seed {
+		out := testSeedOK.DeepCopy()
+		out.Status.Gardener = &gardener_types.Gardener{Version: version}
+		return out
+	}
+
+	testCases := []struct {
+		name     string
+		policy   seeker.Policy
+		seed     *gardener_types.Seed
+		expected []seeker.RejectionReason
+	}{
+		{
+			name:     "backup not required",
+			policy:   seeker.Policy{RequiredConditions: seeker.DefaultPolicy.RequiredConditions},
+			seed:     testSeedSeedBackupBucketsReadyFalse.DeepCopy(),
+			expected: nil,
+		},
+		{
+			name: "additional condition not ready",
+			policy: seeker.Policy{
+				RequiredConditions: []gardener_types.ConditionType{
+					gardener_types.SeedGardenletReady,
+					gardener_types.SeedExtensionsReady,
+				},
+			},
+			seed:     testSeedExtensionsNotReady,
+			expected: []seeker.RejectionReason{seeker.ReasonConditionNotReady},
+		},
+		{
+			name: "additional condition missing",
+			policy: seeker.Policy{
+				RequiredConditions: []gardener_types.ConditionType{
+					gardener_types.SeedSystemComponentsHealthy,
+				},
+			},
+			seed:     testSeedOK.DeepCopy(),
+			expected: []seeker.RejectionReason{seeker.ReasonConditionNotReady},
+		},
+		{
+			name: "label mismatch",
+			policy: seeker.Policy{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"seed.gardener.cloud/kyma": "true"},
+				},
+			},
+			seed:     testSeedOK.DeepCopy(),
+			expected: []seeker.RejectionReason{seeker.ReasonLabelMismatch},
+		},
+		{
+			name: "label match",
+			policy: seeker.Policy{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"seed.gardener.cloud/kyma": "true"},
+				},
+			},
+			seed: testSeedLabeled,
+		},
+		{
+			name:     "taint not tolerated",
+			policy:   seeker.Policy{},
+			seed:     testSeedTainted,
+			expected: []seeker.RejectionReason{seeker.ReasonTainted},
+		},
+		{
+			name:   "taint tolerated",
+			policy: seeker.Policy{}.Tolerate("seed.gardener.cloud/protected"),
+			seed:   testSeedTainted,
+		},
+		{
+			name: "taint tolerated and excluded",
+			policy: seeker.Policy{
+				ExcludedTaints: []string{"seed.gardener.cloud/protected"},
+			}.Tolerate("seed.gardener.cloud/protected"),
+			seed:     testSeedTainted,
+			expected: []seeker.RejectionReason{seeker.ReasonTainted},
+		},
+		{
+			name: "gardenlet version unknown",
+			policy: seeker.Policy{
+				MinGardenletVersion: "v1.100.0",
+			},
+			seed:     testSeedOK.DeepCopy(),
+			expected: []seeker.RejectionReason{seeker.ReasonGardenletOutdated},
+		},
+		{
+			name: "gardenlet outdated",
+			policy: seeker.Policy{
+				MinGardenletVersion: "v1.100.0",
+			},
+			seed:     testSeedGardenletVersion("v1.99.3"),
+			expected: []seeker.RejectionReason{seeker.ReasonGardenletOutdated},
+		},
+		{
+			name: "gardenlet up to date",
+			policy: seeker.Policy{
+				MinGardenletVersion: "v1.100.0",
+			},
+			seed: testSeedGardenletVersion("v1.106.1"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			evaluate, err := testCase.policy.Evaluator()
+			require.NoError(t, err)
+
+			// WHEN
+			actual := evaluate(testCase.seed)
+
+			// THEN
+			require.Equal(t, testCase.expected, reasons(actual))
+		})
+	}
+}
+
+func TestPolicy_TaintRejection(t *testing.T) {
+	// GIVEN
+	seed := testSeedOK.DeepCopy()
+	seed.Spec.Taints = []gardener_types.SeedTaint{
+		{Key: "seed.gardener.cloud/protected"},
+		{Key: "test-taint", Value: ptr.To("test-value")},
+	}
+
+	evaluate, err := seeker.DefaultPolicy.Tolerate("seed.gardener.cloud/protected").Evaluator()
+	require.NoError(t, err)
+
+	// WHEN
+	actual := evaluate(seed)
+
+	// THEN
+	require.Equal(t, []seeker.Rejection{
+		{
+			Reason:  seeker.ReasonTainted,
+			Message: "taint test-taint=test-value is not tolerated",
+			Taint:   "test-taint",
+		},
+	}, actual.Rejections)
+}
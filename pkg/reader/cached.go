@@ -0,0 +1,118 @@
+package reader
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Notify is called with the seeds cache every time it changes, or with the
+// error if it can not be read anymore.
+type Notify func(Cache, error)
+
+type CachedReaderOpts struct {
+	Client kubernetes.Interface
+	// Key is the config map the seeds cache is stored in.
+	Key client.ObjectKey
+	// Resync is the period the config map is resynced with, 0 disables it.
+	Resync time.Duration
+	// Notify is optional, it is called from the informer, so it should not
+	// block.
+	Notify
+}
+
+// CachedReader keeps the seeds cache in memory, it is updated by an informer
+// watching only the config map the seeds cache is stored in.
+type CachedReader struct {
+	opts CachedReaderOpts
+
+	mu    sync.RWMutex
+	cache Cache
+	err   error
+}
+
+func NewCachedReader(opts CachedReaderOpts) *CachedReader {
+	return &CachedReader{
+		opts: opts,
+		err:  ErrNotStored,
+	}
+}
+
+// Start starts the informer and waits until the seeds cache is read, the
+// informer runs until the context is done.
+func (r *CachedReader) Start(ctx context.Context) error {
+	factory := informers.NewSharedInformerFactoryWithOptions(r.opts.Client, r.opts.Resync,
+		informers.WithNamespace(r.opts.Key.Namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", r.opts.Key.Name).String()
+		}),
+	)
+
+	informer := factory.Core().V1().ConfigMaps().Informer()
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: r.update,
+		UpdateFunc: func(_, obj any) {
+			r.update(obj)
+		},
+		DeleteFunc: func(obj any) {
+			if r.matches(obj) {
+				r.set(Cache{}, ErrNotStored)
+			}
+		},
+	}); err != nil {
+		return err
+	}
+
+	factory.Start(ctx.Done())
+	if !toolscache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// Read returns the last read seeds cache, it fails with ErrNotStored if
+// the config map does not exist.
+func (r *CachedReader) Read() (Cache, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cache, r.err
+}
+
+func (r *CachedReader) update(obj any) {
+	if !r.matches(obj) {
+		return
+	}
+	r.set(FromObject(obj.(*corev1.ConfigMap)))
+}
+
+// matches filters out the other config maps, in case the field selector is
+// not applied.
+func (r *CachedReader) matches(obj any) bool {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	cm, ok := obj.(*corev1.ConfigMap)
+	return ok && client.ObjectKeyFromObject(cm) == r.opts.Key
+}
+
+// set stores the seeds cache and notifies about it if it changed.
+func (r *CachedReader) set(cache Cache, err error) {
+	r.mu.Lock()
+	changed := !reflect.DeepEqual(r.cache, cache) || !reflect.DeepEqual(r.err, err)
+	r.cache, r.err = cache, err
+	r.mu.Unlock()
+
+	if changed && r.opts.Notify != nil {
+		r.opts.Notify(cache, err)
+	}
+}
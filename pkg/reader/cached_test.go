@@ -0,0 +1,79 @@
+package reader_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/reader"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var testKey = client.ObjectKey{Name: "gardener-seeds-cache", Namespace: "kcp-system"}
+
+func testConfigMap(t *testing.T, providers types.Providers) *corev1.ConfigMap {
+	data, err := seeker.ToConfigMap(providers)
+	require.NoError(t, err)
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: testKey.Name, Namespace: testKey.Namespace},
+		Data:       data,
+	}
+}
+
+func TestCachedReader(t *testing.T) {
+	// GIVEN
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clientset := fake.NewClientset(testConfigMap(t, testProviders))
+	notified := make(chan reader.Cache, 10)
+	cachedReader := reader.NewCachedReader(reader.CachedReaderOpts{
+		Client: clientset,
+		Key:    testKey,
+		Notify: func(cache reader.Cache, _ error) {
+			notified <- cache
+		},
+	})
+
+	// WHEN
+	require.NoError(t, cachedReader.Start(ctx))
+
+	// THEN
+	cache, err := cachedReader.Read()
+	require.NoError(t, err)
+	require.Equal(t, testProviders, cache.Providers)
+	require.Equal(t, testProviders, (<-notified).Providers)
+
+	// WHEN
+	changed := types.Providers{
+		"gcp": types.ProviderInfo{SeedRegions: []string{"europe-west1"}},
+	}
+	_, err = clientset.CoreV1().ConfigMaps(testKey.Namespace).Update(ctx, testConfigMap(t, changed), metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// THEN
+	select {
+	case cache := <-notified:
+		require.Equal(t, changed, cache.Providers)
+		require.True(t, cache.HasRegion("gcp", "europe-west1"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("change not notified")
+	}
+
+	// WHEN
+	require.NoError(t, clientset.CoreV1().ConfigMaps(testKey.Namespace).Delete(ctx, testKey.Name, metav1.DeleteOptions{}))
+
+	// THEN
+	require.Eventually(t, func() bool {
+		_, err := cachedReader.Read()
+		return errors.Is(err, reader.ErrNotStored)
+	}, 5*time.Second, 10*time.Millisecond)
+}
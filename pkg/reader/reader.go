@@ -0,0 +1,124 @@
+// Package reader reads the seeds cache written by the gardener-syncer, with
+// its freshness, so the consumers do not parse the stored format on their
+// own.
+package reader
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var ErrNotStored = errors.New("seeds cache not stored")
+
+// Freshness describes how current the seeds cache is.
+type Freshness struct {
+	// LastSuccessfulFetch is the time the data was last fetched from the
+	// gardens, zero if unknown.
+	LastSuccessfulFetch time.Time
+	// LastChange is the time the data last changed, zero if unknown.
+	LastChange time.Time
+	// Stale is true if the fetches from the gardens failed since the data
+	// was fetched.
+	Stale bool
+}
+
+// Age returns the time since the last successful fetch.
+func (f Freshness) Age() time.Duration {
+	return time.Since(f.LastSuccessfulFetch)
+}
+
+// FreshWithin returns true if the data is not stale and was fetched within
+// the max age.
+func (f Freshness) FreshWithin(maxAge time.Duration) bool {
+	return !f.Stale && !f.LastSuccessfulFetch.IsZero() && f.Age() <= maxAge
+}
+
+// Cache is the seeds cache and its freshness.
+type Cache struct {
+	Providers     types.Providers
+	SchemaVersion types.SchemaVersion
+	Freshness
+}
+
+// HasRegion returns true if the provider has seeds in the region.
+func (c Cache) HasRegion(provider, region string) bool {
+	return slices.Contains(c.Providers[provider].SeedRegions, region)
+}
+
+// RegionsFor returns the seed regions of the provider, none if the provider
+// is not known.
+func (c Cache) RegionsFor(provider string) []string {
+	return slices.Clone(c.Providers[provider].SeedRegions)
+}
+
+// Read reads the seeds cache from the sink, it fails with ErrNotStored if
+// nothing is stored yet.
+func Read(ctx context.Context, sink seeker.Sink) (Cache, error) {
+	doc, err := sink.Read(ctx)
+	if err != nil {
+		return Cache{}, err
+	}
+	return FromDocument(doc)
+}
+
+// ReadConfigMap reads the seeds cache from the config map.
+func ReadConfigMap(ctx context.Context, get seeker.Get, key client.ObjectKey) (Cache, error) {
+	return Read(ctx, seeker.ConfigMapSink{Key: key, Get: get})
+}
+
+// FromConfigMap decodes the config map data written by seeker.ToConfigMap, in
+// any schema version.
+func FromConfigMap(data map[string]string) (types.Providers, error) {
+	providers, _, err := types.Decode(data)
+	return providers, err
+}
+
+// FromObject decodes the seeds cache from the config map it is stored in.
+func FromObject(cm *corev1.ConfigMap) (Cache, error) {
+	return FromDocument(seeker.Document{Annotations: cm.Annotations, Data: cm.Data})
+}
+
+// FromDocument decodes the seeds cache from the stored document.
+func FromDocument(doc seeker.Document) (Cache, error) {
+	if len(doc.Data) == 0 {
+		return Cache{}, ErrNotStored
+	}
+
+	providers, version, err := types.Decode(doc.Data)
+	if err != nil {
+		return Cache{}, err
+	}
+
+	// the documents stored before the fetch time was recorded were written
+	// right after the fetch
+	lastSuccessfulFetch := doc.Annotations[seeker.LastSuccessfulFetchAnnotation]
+	if lastSuccessfulFetch == "" {
+		lastSuccessfulFetch = doc.Annotations[seeker.LastSyncAnnotation]
+	}
+
+	return Cache{
+		Providers:     providers,
+		SchemaVersion: version,
+		Freshness: Freshness{
+			LastSuccessfulFetch: parseTime(lastSuccessfulFetch),
+			LastChange:          parseTime(doc.Annotations[seeker.LastChangeAnnotation]),
+			Stale:               doc.Annotations[seeker.StaleAnnotation] == "true",
+		},
+	}, nil
+}
+
+// parseTime returns the zero time if the timestamp is not valid.
+func parseTime(timestamp string) time.Time {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
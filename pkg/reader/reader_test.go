@@ -0,0 +1,192 @@
+package reader_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/reader"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+var testProviders = types.Providers{
+	"aws": types.ProviderInfo{SeedRegions: []string{"eu-central-1"}},
+}
+
+func TestFromDocument(t *testing.T) {
+	data, err := seeker.ToConfigMap(testProviders)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	hourAgo := now.Add(-time.Hour)
+
+	testCases := []struct {
+		name              string
+		doc               seeker.Document
+		maxAge            time.Duration
+		expectedError     error
+		expectedFreshness reader.Freshness
+		expectedFresh     bool
+	}{
+		{
+			name: "OK1: fresh",
+			doc: seeker.Document{
+				Annotations: map[string]string{
+					seeker.LastSuccessfulFetchAnnotation: now.Format(time.RFC3339),
+					seeker.LastChangeAnnotation:          hourAgo.Format(time.RFC3339),
+				},
+				Data: data,
+			},
+			maxAge: time.Minute,
+			expectedFreshness: reader.Freshness{
+				LastSuccessfulFetch: now,
+				LastChange:          hourAgo,
+			},
+			expectedFresh: true,
+		},
+		{
+			name: "OK2: stale",
+			doc: seeker.Document{
+				Annotations: map[string]string{
+					seeker.LastSuccessfulFetchAnnotation: now.Format(time.RFC3339),
+					seeker.StaleAnnotation:               "true",
+				},
+				Data: data,
+			},
+			maxAge: time.Minute,
+			expectedFreshness: reader.Freshness{
+				LastSuccessfulFetch: now,
+				Stale:               true,
+			},
+		},
+		{
+			name: "OK3: too old",
+			doc: seeker.Document{
+				Annotations: map[string]string{
+					seeker.LastSuccessfulFetchAnnotation: hourAgo.Format(time.RFC3339),
+				},
+				Data: data,
+			},
+			maxAge: time.Minute,
+			expectedFreshness: reader.Freshness{
+				LastSuccessfulFetch: hourAgo,
+			},
+		},
+		{
+			name: "OK4: last sync used without the last successful fetch",
+			doc: seeker.Document{
+				Annotations: map[string]string{
+					seeker.LastSyncAnnotation: now.Format(time.RFC3339),
+				},
+				Data: data,
+			},
+			maxAge: time.Minute,
+			expectedFreshness: reader.Freshness{
+				LastSuccessfulFetch: now,
+			},
+			expectedFresh: true,
+		},
+		{
+			name:   "OK5: no timestamps",
+			doc:    seeker.Document{Data: data},
+			maxAge: time.Hour,
+		},
+		{
+			name:          "ERR1: nothing stored",
+			doc:           seeker.Document{},
+			expectedError: reader.ErrNotStored,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			cache, err := reader.FromDocument(testCase.doc)
+
+			// THEN
+			if testCase.expectedError != nil {
+				require.ErrorIs(t, err, testCase.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testProviders, cache.Providers)
+			require.Equal(t, types.SchemaV1, cache.SchemaVersion)
+			require.Equal(t, testCase.expectedFreshness, cache.Freshness)
+			require.Equal(t, testCase.expectedFresh, cache.FreshWithin(testCase.maxAge))
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	// GIVEN
+	sink := seeker.FileSink{Path: filepath.Join(t.TempDir(), "cache.yaml"), Format: seeker.FileFormatYAML}
+	store := seeker.BuildStoreFn(seeker.StoreOpts{
+		Sink:    sink,
+		Timeout: time.Second,
+		Convert: seeker.ToConfigMap,
+	})
+	require.NoError(t, store(context.Background(), testProviders))
+
+	// WHEN
+	cache, err := reader.Read(context.Background(), sink)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, testProviders, cache.Providers)
+	require.False(t, cache.Stale)
+	require.True(t, cache.FreshWithin(time.Minute))
+}
+
+func TestFromConfigMap(t *testing.T) {
+	testCases := []struct {
+		name      string
+		providers types.Providers
+		version   types.SchemaVersion
+	}{
+		{
+			name:      "OK1: schema v1",
+			providers: testProviders,
+			version:   types.SchemaV1,
+		},
+		{
+			name:      "OK2: schema v2",
+			providers: testProviders,
+			version:   types.SchemaV2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			data, err := seeker.ToConfigMapFn(testCase.version)(testCase.providers)
+			require.NoError(t, err)
+
+			// WHEN
+			providers, err := reader.FromConfigMap(data)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.providers, providers)
+		})
+	}
+}
+
+func TestCache_Regions(t *testing.T) {
+	// GIVEN
+	cache := reader.Cache{Providers: testProviders}
+
+	// WHEN
+	regions := cache.RegionsFor("aws")
+	regions[0] = "modified"
+
+	// THEN
+	require.Equal(t, []string{"eu-central-1"}, cache.RegionsFor("aws"))
+	require.Empty(t, cache.RegionsFor("gcp"))
+	require.True(t, cache.HasRegion("aws", "eu-central-1"))
+	require.False(t, cache.HasRegion("aws", "us-east-1"))
+	require.False(t, cache.HasRegion("gcp", "eu-central-1"))
+}
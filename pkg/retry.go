@@ -0,0 +1,103 @@
+package seeker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	log "log/slog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type RetryOpts struct {
+	// Attempts is the maximum number of attempts of a request, 1 or less
+	// disables the retries.
+	Attempts       int
+	InitialBackoff time.Duration
+	// MaxBackoff bounds both the backoff and the delay requested by the
+	// server with Retry-After.
+	MaxBackoff time.Duration
+}
+
+// IsRetriable returns true if the request may succeed if it is sent again:
+// the server is overloaded or unavailable, the object was modified
+// concurrently or the connection was lost. The other errors are terminal.
+func IsRetriable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	switch {
+	case apierrors.IsTooManyRequests(err),
+		apierrors.IsServerTimeout(err),
+		apierrors.IsTimeout(err),
+		apierrors.IsConflict(err),
+		apierrors.IsInternalError(err),
+		apierrors.IsServiceUnavailable(err),
+		apierrors.IsUnexpectedServerError(err):
+		return true
+	case utilnet.IsConnectionReset(err),
+		utilnet.IsConnectionRefused(err),
+		utilnet.IsProbableEOF(err),
+		utilnet.IsHTTP2ConnectionLost(err):
+		return true
+	}
+
+	var status apierrors.APIStatus
+	return errors.As(err, &status) && status.Status().Code >= http.StatusInternalServerError
+}
+
+// retry runs the request until it succeeds, fails with a terminal error, or
+// runs out of attempts. The delay between the attempts is the one requested
+// by the server, or the backoff. The last error is returned.
+func retry(ctx context.Context, opts RetryOpts, request string, do func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := do()
+		if attempt >= opts.Attempts || !IsRetriable(err) {
+			return err
+		}
+
+		delay := exponentialBackoff(opts.InitialBackoff, opts.MaxBackoff, attempt)
+		if seconds, ok := apierrors.SuggestsClientDelay(err); ok {
+			delay = min(time.Duration(seconds)*time.Second, opts.MaxBackoff)
+		}
+
+		log.With("request", request, "attempt", attempt, "delay", delay, "error", err).Warn("request failed, retrying")
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// RetryList builds a list retrying the retriable errors.
+func RetryList(list List, opts RetryOpts) List {
+	return func(ctx context.Context, obj client.ObjectList, listOpts ...client.ListOption) error {
+		return retry(ctx, opts, "list", func() error {
+			return list(ctx, obj, listOpts...)
+		})
+	}
+}
+
+// RetryGet builds a get retrying the retriable errors.
+func RetryGet(get Get, opts RetryOpts) Get {
+	return func(ctx context.Context, key client.ObjectKey, obj client.Object, getOpts ...client.GetOption) error {
+		return retry(ctx, opts, "get", func() error {
+			return get(ctx, key, obj, getOpts...)
+		})
+	}
+}
+
+// RetryPatch builds a patch retrying the retriable errors.
+func RetryPatch(patch Patch, opts RetryOpts) Patch {
+	return func(ctx context.Context, obj client.Object, p client.Patch, patchOpts ...client.PatchOption) error {
+		return retry(ctx, opts, "patch", func() error {
+			return patch(ctx, obj, p, patchOpts...)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package seeker_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var testResource = schema.GroupResource{Resource: "configmaps"}
+
+func TestIsRetriable(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "too many requests", err: apierrors.NewTooManyRequests("test", 1), expected: true},
+		{name: "server timeout", err: apierrors.NewServerTimeout(testResource, "list", 1), expected: true},
+		{name: "gateway timeout", err: apierrors.NewTimeoutError("test", 1), expected: true},
+		{name: "conflict", err: apierrors.NewConflict(testResource, testName, fmt.Errorf("test")), expected: true},
+		{name: "internal error", err: apierrors.NewInternalError(fmt.Errorf("test")), expected: true},
+		{name: "service unavailable", err: apierrors.NewServiceUnavailable("test"), expected: true},
+		{name: "bad gateway", err: apierrors.NewGenericServerResponse(502, "get", testResource, testName, "test", 0, true), expected: true},
+		{name: "connection reset", err: fmt.Errorf("test: %w", syscall.ECONNRESET), expected: true},
+		{name: "unexpected EOF", err: io.ErrUnexpectedEOF, expected: true},
+		{name: "not found", err: apierrors.NewNotFound(testResource, testName)},
+		{name: "forbidden", err: apierrors.NewForbidden(testResource, testName, fmt.Errorf("test"))},
+		{name: "invalid", err: apierrors.NewBadRequest("test")},
+		{name: "context canceled", err: context.Canceled},
+		{name: "context deadline exceeded", err: context.DeadlineExceeded},
+		{name: "no error"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual := seeker.IsRetriable(testCase.err)
+
+			// THEN
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestRetryGet(t *testing.T) {
+	testCases := []struct {
+		name          string
+		results       []error
+		attempts      int
+		expectedErr   error
+		expectedCalls int
+	}{
+		{
+			name:          "OK1: succeeded after retriable errors",
+			results:       []error{apierrors.NewTooManyRequests("test", 1), apierrors.NewConflict(testResource, testName, fmt.Errorf("test"))},
+			attempts:      3,
+			expectedCalls: 3,
+		},
+		{
+			name:          "OK2: succeeded at once",
+			attempts:      3,
+			expectedCalls: 1,
+		},
+		{
+			name:          "ERR1: terminal error not retried",
+			results:       []error{apierrors.NewNotFound(testResource, testName)},
+			attempts:      3,
+			expectedErr:   apierrors.NewNotFound(testResource, testName),
+			expectedCalls: 1,
+		},
+		{
+			name:          "ERR2: out of attempts",
+			results:       []error{apierrors.NewServiceUnavailable("test"), apierrors.NewServiceUnavailable("test"), apierrors.NewServiceUnavailable("test")},
+			attempts:      2,
+			expectedErr:   apierrors.NewServiceUnavailable("test"),
+			expectedCalls: 2,
+		},
+		{
+			name:          "ERR3: retries disabled",
+			results:       []error{apierrors.NewServiceUnavailable("test")},
+			attempts:      1,
+			expectedErr:   apierrors.NewServiceUnavailable("test"),
+			expectedCalls: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			var calls int
+			get := seeker.RetryGet(func(_ context.Context, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+				calls++
+				if calls > len(testCase.results) {
+					return nil
+				}
+				return testCase.results[calls-1]
+			}, seeker.RetryOpts{
+				Attempts:       testCase.attempts,
+				InitialBackoff: time.Millisecond,
+				MaxBackoff:     time.Millisecond,
+			})
+
+			// WHEN
+			err := get(context.Background(), testKey, &corev1.ConfigMap{})
+
+			// THEN
+			require.Equal(t, testCase.expectedErr, err)
+			require.Equal(t, testCase.expectedCalls, calls)
+		})
+	}
+}
+
+func TestRetryPatch_ContextDone(t *testing.T) {
+	// GIVEN
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int
+	patch := seeker.RetryPatch(func(_ context.Context, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+		calls++
+		cancel()
+		return apierrors.NewTooManyRequests("test", 60)
+	}, seeker.RetryOpts{
+		Attempts:       3,
+		InitialBackoff: time.Hour,
+		MaxBackoff:     time.Hour,
+	})
+
+	// WHEN
+	err := patch(ctx, &corev1.ConfigMap{}, client.Apply)
+
+	// THEN
+	require.True(t, apierrors.IsTooManyRequests(err))
+	require.Equal(t, 1, calls)
+}
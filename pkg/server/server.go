@@ -0,0 +1,245 @@
+// Package server serves the seeds cache over HTTP, for the consumers that
+// can not read it from KCP.
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "log/slog"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/reader"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// State holds the providers of the last successful sync.
+type State struct {
+	staleness time.Duration
+
+	mu sync.RWMutex
+	// started is when the replica started leading, it runs no syncs before.
+	started     time.Time
+	leading     bool
+	providers   types.Providers
+	lastSuccess time.Time
+}
+
+// NewState returns the state of the syncs, the syncs older than the
+// staleness window are considered failed.
+func NewState(staleness time.Duration) *State {
+	return &State{
+		staleness: staleness,
+		started:   time.Now(),
+		leading:   true,
+	}
+}
+
+// SetLeading records whether the replica runs the syncs, a replica that is
+// not the leader is healthy without any successful sync. The state leads
+// by default.
+func (s *State) SetLeading(leading bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if leading && !s.leading {
+		s.started = time.Now()
+	}
+	s.leading = leading
+}
+
+// InstrumentStore records the providers stored successfully.
+func (s *State) InstrumentStore(store seeker.Store) seeker.Store {
+	return func(ctx context.Context, providers types.Providers) error {
+		if err := store(ctx, providers); err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.providers = providers.Sorted()
+		s.lastSuccess = time.Now()
+		return nil
+	}
+}
+
+// ReadStored fills the state from the document stored in the sink if the
+// replica is not leading, so the followers serve the providers stored by
+// the leader. A stale document is served, but the read is not a successful
+// sync, the last successful fetch of the document is then.
+func (s *State) ReadStored(ctx context.Context, sink seeker.Sink) error {
+	cache, err := reader.Read(ctx, sink)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.leading {
+		return nil
+	}
+	s.providers = cache.Providers.Sorted()
+	s.lastSuccess = time.Now()
+	if cache.Stale {
+		s.lastSuccess = cache.LastSuccessfulFetch
+	}
+	return nil
+}
+
+// Follow reads the stored document every interval until the context is
+// done, the reads are limited by the timeout. The failures are logged, the
+// state keeps the last read providers.
+func (s *State) Follow(ctx context.Context, sink seeker.Sink, interval, timeout time.Duration) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		if err := s.ReadStored(ctx, sink); err != nil && !errors.Is(err, reader.ErrNotStored) {
+			log.With("sink", sink.String(), "error", err).Warn("unable to read stored providers")
+		}
+	}, interval)
+}
+
+func (s *State) get() (types.Providers, time.Time) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.providers, s.lastSuccess
+}
+
+// Handler returns the handler of the read API:
+//   - /v1/providers serves all the providers
+//   - /v1/providers/{type}/regions serves the regions of one provider
+//   - /healthz fails if no sync succeeded within the staleness window since
+//     the replica started leading
+//   - /readyz fails if there are no providers from a sync within the
+//     staleness window, the followers read the providers with ReadStored
+func (s *State) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/providers", s.handleProviders)
+	mux.HandleFunc("GET /v1/providers/{type}/regions", s.handleRegions)
+	mux.HandleFunc("GET /healthz", s.handleHealthz)
+	mux.HandleFunc("GET /readyz", s.handleReadyz)
+	return mux
+}
+
+func (s *State) handleProviders(w http.ResponseWriter, r *http.Request) {
+	providers, lastSuccess := s.get()
+	if lastSuccess.IsZero() {
+		http.Error(w, "no successful sync yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	writeJSON(w, r, providers)
+}
+
+func (s *State) handleRegions(w http.ResponseWriter, r *http.Request) {
+	providers, lastSuccess := s.get()
+	if lastSuccess.IsZero() {
+		http.Error(w, "no successful sync yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	provider, found := providers[r.PathValue("type")]
+	if !found {
+		http.Error(w, "provider not found", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, r, provider.SeedRegions)
+}
+
+func (s *State) handleHealthz(w http.ResponseWriter, _ *http.Request) {
+	s.mu.RLock()
+	leading, lastSuccess := s.leading, s.lastSuccess
+	if lastSuccess.Before(s.started) {
+		lastSuccess = s.started
+	}
+	s.mu.RUnlock()
+
+	writeStatus(w, !leading || time.Since(lastSuccess) <= s.staleness)
+}
+
+func (s *State) handleReadyz(w http.ResponseWriter, _ *http.Request) {
+	_, lastSuccess := s.get()
+	writeStatus(w, !lastSuccess.IsZero() && time.Since(lastSuccess) <= s.staleness)
+}
+
+func writeStatus(w http.ResponseWriter, ok bool) {
+	if !ok {
+		http.Error(w, "last successful sync is stale", http.StatusServiceUnavailable)
+		return
+	}
+	_, _ = w.Write([]byte("ok"))
+}
+
+// writeJSON writes the value with its ETag, or only the status if the
+// client has it already.
+func writeJSON(w http.ResponseWriter, r *http.Request, value any) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	w.Header().Set("ETag", etag)
+	if noneMatch(r.Header.Values("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// noneMatch returns true if any of the If-None-Match header values matches
+// the ETag. The values are lists of ETags or "*", they are compared weakly,
+// so the weak validators match too.
+func noneMatch(values []string, etag string) bool {
+	for _, value := range values {
+		for _, candidate := range strings.Split(value, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Serve serves the read API handler on the given address until the context
+// is done.
+func Serve(ctx context.Context, bindAddress string, handler http.Handler) error {
+	log.With("address", bindAddress).Info("serving read API")
+	return ListenAndServe(ctx, bindAddress, handler)
+}
+
+// ListenAndServe serves the handler on the given address until the context
+// is done, the server is shut down then.
+func ListenAndServe(ctx context.Context, bindAddress string, handler http.Handler) error {
+	server := &http.Server{
+		Addr:              bindAddress,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(context.Background()); err != nil {
+			log.Error(err.Error())
+		}
+	}()
+
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
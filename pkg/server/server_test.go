@@ -0,0 +1,290 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/server"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+var testProviders = types.Providers{
+	"aws": types.ProviderInfo{SeedRegions: []string{"eu-west-1", "eu-central-1"}},
+}
+
+var errStoreTest = fmt.Errorf("store test")
+
+func storeOK(context.Context, types.Providers) error { return nil }
+
+func storeFailed(context.Context, types.Providers) error { return errStoreTest }
+
+func TestHandler(t *testing.T) {
+	testCases := []struct {
+		name           string
+		staleness      time.Duration
+		stores         []func(context.Context, types.Providers) error
+		notLeading     bool
+		path           string
+		ifNoneMatch    bool
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "OK1: providers",
+			staleness:      time.Hour,
+			stores:         []func(context.Context, types.Providers) error{storeOK},
+			path:           "/v1/providers",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"aws":{"seedRegions":["eu-central-1","eu-west-1"]}}`,
+		},
+		{
+			name:           "OK2: regions of a provider",
+			staleness:      time.Hour,
+			stores:         []func(context.Context, types.Providers) error{storeOK},
+			path:           "/v1/providers/aws/regions",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `["eu-central-1","eu-west-1"]`,
+		},
+		{
+			name:           "OK3: providers not modified",
+			staleness:      time.Hour,
+			stores:         []func(context.Context, types.Providers) error{storeOK},
+			path:           "/v1/providers",
+			ifNoneMatch:    true,
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "OK4: ready",
+			staleness:      time.Hour,
+			stores:         []func(context.Context, types.Providers) error{storeOK},
+			path:           "/readyz",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "ok",
+		},
+		{
+			name:           "OK5: healthy before the first sync",
+			staleness:      time.Hour,
+			path:           "/healthz",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "ok",
+		},
+		{
+			name:           "OK6: last successful providers served after a failed store",
+			staleness:      time.Hour,
+			stores:         []func(context.Context, types.Providers) error{storeOK, storeFailed},
+			path:           "/v1/providers/aws/regions",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `["eu-central-1","eu-west-1"]`,
+		},
+		{
+			name:           "OK7: healthy without syncs if not leading",
+			staleness:      time.Nanosecond,
+			notLeading:     true,
+			path:           "/healthz",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "ok",
+		},
+		{
+			name:           "ERR1: no successful sync yet",
+			staleness:      time.Hour,
+			stores:         []func(context.Context, types.Providers) error{storeFailed},
+			path:           "/v1/providers",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "ERR2: unknown provider",
+			staleness:      time.Hour,
+			stores:         []func(context.Context, types.Providers) error{storeOK},
+			path:           "/v1/providers/gcp/regions",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "ERR3: not ready before the first sync",
+			staleness:      time.Hour,
+			path:           "/readyz",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "ERR4: not ready with a stale sync",
+			staleness:      time.Nanosecond,
+			stores:         []func(context.Context, types.Providers) error{storeOK},
+			path:           "/readyz",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "ERR5: not healthy with a stale sync",
+			staleness:      time.Nanosecond,
+			stores:         []func(context.Context, types.Providers) error{storeOK},
+			path:           "/healthz",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			state := server.NewState(testCase.staleness)
+			for _, store := range testCase.stores {
+				_ = state.InstrumentStore(store)(context.Background(), testProviders)
+			}
+			if testCase.notLeading {
+				state.SetLeading(false)
+			}
+			handler := state.Handler()
+
+			request := httptest.NewRequest(http.MethodGet, testCase.path, nil)
+			if testCase.ifNoneMatch {
+				first := httptest.NewRecorder()
+				handler.ServeHTTP(first, request)
+				request.Header.Set("If-None-Match", first.Header().Get("ETag"))
+			}
+
+			// WHEN
+			response := httptest.NewRecorder()
+			handler.ServeHTTP(response, request)
+
+			// THEN
+			require.Equal(t, testCase.expectedStatus, response.Code)
+			if testCase.expectedBody != "" {
+				require.Equal(t, testCase.expectedBody, response.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_IfNoneMatch(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ifNoneMatch    func(etag string) []string
+		expectedStatus int
+	}{
+		{
+			name:           "OK1: no header",
+			ifNoneMatch:    func(string) []string { return nil },
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "OK2: other ETag",
+			ifNoneMatch:    func(string) []string { return []string{`"other"`} },
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "OK3: ETag",
+			ifNoneMatch:    func(etag string) []string { return []string{etag} },
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "OK4: weak ETag",
+			ifNoneMatch:    func(etag string) []string { return []string{"W/" + etag} },
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "OK5: ETag in a list",
+			ifNoneMatch:    func(etag string) []string { return []string{`"other", ` + etag} },
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "OK6: ETag in another header",
+			ifNoneMatch:    func(etag string) []string { return []string{`"other"`, etag} },
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "OK7: any ETag",
+			ifNoneMatch:    func(string) []string { return []string{"*"} },
+			expectedStatus: http.StatusNotModified,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			state := server.NewState(time.Hour)
+			require.NoError(t, state.InstrumentStore(storeOK)(context.Background(), testProviders))
+			handler := state.Handler()
+
+			first := httptest.NewRecorder()
+			handler.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/v1/providers", nil))
+			request := httptest.NewRequest(http.MethodGet, "/v1/providers", nil)
+			for _, value := range testCase.ifNoneMatch(first.Header().Get("ETag")) {
+				request.Header.Add("If-None-Match", value)
+			}
+
+			// WHEN
+			response := httptest.NewRecorder()
+			handler.ServeHTTP(response, request)
+
+			// THEN
+			require.Equal(t, testCase.expectedStatus, response.Code)
+		})
+	}
+}
+
+func TestState_ReadStored(t *testing.T) {
+	testCases := []struct {
+		name              string
+		leading           bool
+		stale             bool
+		expectedProviders int
+		expectedReady     int
+	}{
+		{
+			name:              "OK1: follower serves the stored providers",
+			expectedProviders: http.StatusOK,
+			expectedReady:     http.StatusOK,
+		},
+		{
+			name:              "OK2: follower serves the stale providers, not ready",
+			stale:             true,
+			expectedProviders: http.StatusOK,
+			expectedReady:     http.StatusServiceUnavailable,
+		},
+		{
+			name:              "OK3: leader does not read the stored providers",
+			leading:           true,
+			expectedProviders: http.StatusServiceUnavailable,
+			expectedReady:     http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			sink := seeker.FileSink{Path: filepath.Join(t.TempDir(), "cache.yaml"), Format: seeker.FileFormatYAML}
+			store := seeker.BuildStoreFn(seeker.StoreOpts{Sink: sink, Convert: seeker.ToConfigMap, Timeout: time.Second})
+			require.NoError(t, store(context.Background(), testProviders))
+			if testCase.stale {
+				// the leader fetched the data two hours ago and failed since
+				doc, err := sink.Read(context.Background())
+				require.NoError(t, err)
+				doc.Annotations[seeker.LastSuccessfulFetchAnnotation] = time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)
+				doc.Annotations[seeker.StaleAnnotation] = "true"
+				require.NoError(t, sink.Write(context.Background(), doc))
+			}
+
+			state := server.NewState(time.Hour)
+			state.SetLeading(testCase.leading)
+
+			// WHEN
+			err := state.ReadStored(context.Background(), sink)
+
+			// THEN
+			require.NoError(t, err)
+			for path, expectedStatus := range map[string]int{
+				"/v1/providers": testCase.expectedProviders,
+				"/readyz":       testCase.expectedReady,
+				"/healthz":      http.StatusOK,
+			} {
+				response := httptest.NewRecorder()
+				state.Handler().ServeHTTP(response, httptest.NewRequest(http.MethodGet, path, nil))
+				require.Equal(t, expectedStatus, response.Code, path)
+			}
+		})
+	}
+}
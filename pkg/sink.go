@@ -0,0 +1,329 @@
+package seeker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Document is the seeds cache the way every sink stores it, the converted
+// data and the annotations describing it.
+type Document struct {
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Data        map[string]string `json:"data"`
+}
+
+// Sink is where the seeds cache is stored.
+type Sink interface {
+	// String describes the sink in logs and dry-run output.
+	String() string
+	// Read returns the stored document, or an empty one if nothing is
+	// stored yet.
+	Read(context.Context) (Document, error)
+	Write(context.Context, Document) error
+	// DryRun returns what the sink would store, without storing it.
+	DryRun(context.Context, Document) (any, error)
+}
+
+// ConfigMapSink stores the seeds cache in a config map, the data keys are
+// the config map keys.
+type ConfigMapSink struct {
+	Key client.ObjectKey
+	Get
+	Patch
+}
+
+func (s ConfigMapSink) String() string {
+	return fmt.Sprintf("config-map %s", s.Key)
+}
+
+func (s ConfigMapSink) Read(ctx context.Context) (Document, error) {
+	var cm corev1.ConfigMap
+	if err := s.Get(ctx, s.Key, &cm); err != nil {
+		return Document{}, ignoreNotFound(err)
+	}
+	return Document{Annotations: cm.Annotations, Data: cm.Data}, nil
+}
+
+func (s ConfigMapSink) Object(ctx context.Context) (client.Object, error) {
+	var cm corev1.ConfigMap
+	return &cm, s.Get(ctx, s.Key, &cm)
+}
+
+func (s ConfigMapSink) Write(ctx context.Context, doc Document) error {
+	return applyConfigMap(ctx, s.Patch, s.Key, s.configMap(doc))
+}
+
+func (s ConfigMapSink) DryRun(ctx context.Context, doc Document) (any, error) {
+	cm := s.configMap(doc)
+	return cm, applyConfigMap(ctx, s.Patch, s.Key, cm, client.DryRunAll)
+}
+
+func (s ConfigMapSink) configMap(doc Document) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{Data: doc.Data}
+	cm.Annotations = doc.Annotations
+	return cm
+}
+
+// SecretSink stores the seeds cache in a secret, the data keys are the
+// secret keys.
+type SecretSink struct {
+	Key client.ObjectKey
+	Get
+	Patch
+}
+
+func (s SecretSink) String() string {
+	return fmt.Sprintf("secret %s", s.Key)
+}
+
+func (s SecretSink) Read(ctx context.Context) (Document, error) {
+	var secret corev1.Secret
+	if err := s.Get(ctx, s.Key, &secret); err != nil {
+		return Document{}, ignoreNotFound(err)
+	}
+
+	data := make(map[string]string, len(secret.Data))
+	for k, v := range secret.Data {
+		data[k] = string(v)
+	}
+	return Document{Annotations: secret.Annotations, Data: data}, nil
+}
+
+func (s SecretSink) Object(ctx context.Context) (client.Object, error) {
+	var secret corev1.Secret
+	return &secret, s.Get(ctx, s.Key, &secret)
+}
+
+func (s SecretSink) Write(ctx context.Context, doc Document) error {
+	return s.apply(ctx, s.secret(doc))
+}
+
+func (s SecretSink) DryRun(ctx context.Context, doc Document) (any, error) {
+	secret := s.secret(doc)
+	return secret, s.apply(ctx, secret, client.DryRunAll)
+}
+
+func (s SecretSink) secret(doc Document) *corev1.Secret {
+	secret := &corev1.Secret{
+		Type: corev1.SecretTypeOpaque,
+		Data: make(map[string][]byte, len(doc.Data)),
+	}
+	secret.Name = s.Key.Name
+	secret.Namespace = s.Key.Namespace
+	secret.Annotations = doc.Annotations
+	secret.TypeMeta.Kind = "Secret"
+	secret.TypeMeta.APIVersion = "v1"
+	for k, v := range doc.Data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func (s SecretSink) apply(ctx context.Context, secret *corev1.Secret, opts ...client.PatchOption) error {
+	opts = append(opts, &client.PatchOptions{
+		FieldManager: FieldManagerName,
+	})
+	return s.Patch(ctx, secret, client.Apply, opts...)
+}
+
+// CustomResourceSink stores the seeds cache in the spec.data field of a
+// custom resource of any kind.
+type CustomResourceSink struct {
+	Key client.ObjectKey
+	GVK schema.GroupVersionKind
+	Get
+	Patch
+}
+
+func (s CustomResourceSink) String() string {
+	return fmt.Sprintf("%s %s", s.GVK.Kind, s.Key)
+}
+
+func (s CustomResourceSink) Read(ctx context.Context) (Document, error) {
+	var u unstructured.Unstructured
+	u.SetGroupVersionKind(s.GVK)
+	if err := s.Get(ctx, s.Key, &u); err != nil {
+		return Document{}, ignoreNotFound(err)
+	}
+
+	data, _, err := unstructured.NestedStringMap(u.Object, "spec", "data")
+	if err != nil {
+		return Document{}, err
+	}
+	return Document{Annotations: u.GetAnnotations(), Data: data}, nil
+}
+
+func (s CustomResourceSink) Object(ctx context.Context) (client.Object, error) {
+	var u unstructured.Unstructured
+	u.SetGroupVersionKind(s.GVK)
+	return &u, s.Get(ctx, s.Key, &u)
+}
+
+func (s CustomResourceSink) Write(ctx context.Context, doc Document) error {
+	u, err := s.resource(doc)
+	if err != nil {
+		return err
+	}
+	return s.apply(ctx, u)
+}
+
+func (s CustomResourceSink) DryRun(ctx context.Context, doc Document) (any, error) {
+	u, err := s.resource(doc)
+	if err != nil {
+		return nil, err
+	}
+	return u.Object, s.apply(ctx, u, client.DryRunAll)
+}
+
+func (s CustomResourceSink) resource(doc Document) (*unstructured.Unstructured, error) {
+	u := &unstructured.Unstructured{Object: map[string]any{}}
+	u.SetGroupVersionKind(s.GVK)
+	u.SetName(s.Key.Name)
+	u.SetNamespace(s.Key.Namespace)
+	u.SetAnnotations(doc.Annotations)
+
+	data := make(map[string]any, len(doc.Data))
+	for k, v := range doc.Data {
+		data[k] = v
+	}
+
+	if err := unstructured.SetNestedMap(u.Object, data, "spec", "data"); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (s CustomResourceSink) apply(ctx context.Context, u *unstructured.Unstructured, opts ...client.PatchOption) error {
+	opts = append(opts, &client.PatchOptions{
+		FieldManager: FieldManagerName,
+	})
+	return s.Patch(ctx, u, client.Apply, opts...)
+}
+
+type FileFormat string
+
+const (
+	FileFormatYAML FileFormat = "yaml"
+	FileFormatJSON FileFormat = "json"
+)
+
+var FileFormats = []FileFormat{FileFormatYAML, FileFormatJSON}
+
+func (f FileFormat) Validate() error {
+	if !slices.Contains(FileFormats, f) {
+		return fmt.Errorf("unsupported file format: %s", f)
+	}
+	return nil
+}
+
+// DefaultFileMode lets the other tools read the file written by the
+// FileSink.
+const DefaultFileMode os.FileMode = 0o644
+
+// FileSink stores the seeds cache as a document in a local file, for the
+// tools that do not run in KCP.
+type FileSink struct {
+	Path   string
+	Format FileFormat
+	// Mode is the mode of the written file, DefaultFileMode if not set.
+	Mode os.FileMode
+}
+
+func (s FileSink) String() string {
+	return fmt.Sprintf("file %s", s.Path)
+}
+
+func (s FileSink) Read(_ context.Context) (Document, error) {
+	data, err := os.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return Document{}, nil
+	}
+
+	if err != nil {
+		return Document{}, err
+	}
+
+	// JSON is valid YAML, so the file is read in any format
+	var doc Document
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return Document{}, fmt.Errorf("invalid file %s: %w", s.Path, err)
+	}
+	return doc, nil
+}
+
+// Write replaces the file atomically, the readers never see a partially
+// written file. The temporary file is created readable only by the owner,
+// so its mode is set before it replaces the file.
+func (s FileSink) Write(_ context.Context, doc Document) error {
+	data, err := s.marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	mode := s.Mode
+	if mode == 0 {
+		mode = DefaultFileMode
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+func (s FileSink) DryRun(_ context.Context, doc Document) (any, error) {
+	return doc, nil
+}
+
+func (s FileSink) marshal(doc Document) ([]byte, error) {
+	if s.Format == FileFormatJSON {
+		return json.MarshalIndent(doc, "", "  ")
+	}
+	return yaml.Marshal(doc)
+}
+
+// decodeDocument returns the providers of the stored document, no providers
+// if nothing is stored.
+func decodeDocument(doc Document) (types.Providers, error) {
+	if len(doc.Data) == 0 {
+		return types.Providers{}, nil
+	}
+
+	providers, _, err := types.Decode(doc.Data)
+	return providers, err
+}
+
+func ignoreNotFound(err error) error {
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
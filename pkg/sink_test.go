@@ -0,0 +1,171 @@
+package seeker_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var (
+	testDoc = seeker.Document{
+		Annotations: map[string]string{seeker.ContentHashAnnotation: "test-hash"},
+		Data:        map[string]string{"test": "seedRegions:\n- me"},
+	}
+	testKey = client.ObjectKey{Name: testName, Namespace: testNamespace}
+	testGVK = schema.GroupVersionKind{Group: "test.kyma-project.io", Version: "v1", Kind: "TestCache"}
+)
+
+// buildCapturePatch builds a patch that stores the patched object in the
+// fake client, the fake client does not support server-side apply.
+func buildCapturePatch(c client.Client) seeker.Patch {
+	return func(ctx context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+		return c.Create(ctx, obj)
+	}
+}
+
+func TestSinks(t *testing.T) {
+	testCases := []struct {
+		name    string
+		objects []client.Object
+		sink    func(client.Client) seeker.Sink
+	}{
+		{
+			name: "config map",
+			sink: func(c client.Client) seeker.Sink {
+				return seeker.ConfigMapSink{Key: testKey, Get: c.Get, Patch: buildCapturePatch(c)}
+			},
+		},
+		{
+			name: "secret",
+			sink: func(c client.Client) seeker.Sink {
+				return seeker.SecretSink{Key: testKey, Get: c.Get, Patch: buildCapturePatch(c)}
+			},
+		},
+		{
+			name: "custom resource",
+			sink: func(c client.Client) seeker.Sink {
+				return seeker.CustomResourceSink{Key: testKey, GVK: testGVK, Get: c.Get, Patch: buildCapturePatch(c)}
+			},
+		},
+		{
+			name: "file yaml",
+			sink: func(client.Client) seeker.Sink {
+				return seeker.FileSink{Path: filepath.Join(t.TempDir(), "cache.yaml"), Format: seeker.FileFormatYAML}
+			},
+		},
+		{
+			name: "file json",
+			sink: func(client.Client) seeker.Sink {
+				return seeker.FileSink{Path: filepath.Join(t.TempDir(), "cache.json"), Format: seeker.FileFormatJSON}
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			sink := testCase.sink(fake.NewClientBuilder().WithScheme(scheme).Build())
+
+			// WHEN
+			empty, errEmpty := sink.Read(context.Background())
+			errWrite := sink.Write(context.Background(), testDoc)
+			actual, errRead := sink.Read(context.Background())
+
+			// THEN
+			require.NoError(t, errEmpty)
+			require.Empty(t, empty.Data)
+			require.NoError(t, errWrite)
+			require.NoError(t, errRead)
+			require.Equal(t, testDoc, actual)
+		})
+	}
+}
+
+func TestFileSink_Format(t *testing.T) {
+	// GIVEN
+	path := filepath.Join(t.TempDir(), "cache.json")
+	sink := seeker.FileSink{Path: path, Format: seeker.FileFormatJSON}
+
+	// WHEN
+	err := sink.Write(context.Background(), testDoc)
+
+	// THEN
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+  "annotations": {"gardener-syncer.kyma-project.io/content-hash": "test-hash"},
+  "data": {"test": "seedRegions:\n- me"}
+}`, string(data))
+}
+
+func TestFileSink_Mode(t *testing.T) {
+	testCases := []struct {
+		name         string
+		mode         os.FileMode
+		expectedMode os.FileMode
+	}{
+		{
+			name:         "default mode",
+			expectedMode: seeker.DefaultFileMode,
+		},
+		{
+			name:         "configured mode",
+			mode:         0o640,
+			expectedMode: 0o640,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			path := filepath.Join(t.TempDir(), "cache.yaml")
+			sink := seeker.FileSink{Path: path, Format: seeker.FileFormatYAML, Mode: testCase.mode}
+
+			// WHEN
+			err := sink.Write(context.Background(), testDoc)
+
+			// THEN
+			require.NoError(t, err)
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedMode, info.Mode().Perm())
+		})
+	}
+}
+
+func TestCustomResourceSink_DryRun(t *testing.T) {
+	// GIVEN
+	var patched *unstructured.Unstructured
+	sink := seeker.CustomResourceSink{
+		Key: testKey,
+		GVK: testGVK,
+		Patch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+			patched = obj.(*unstructured.Unstructured)
+			return nil
+		},
+	}
+
+	// WHEN
+	_, err := sink.DryRun(context.Background(), testDoc)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, testGVK, patched.GroupVersionKind())
+	require.Equal(t, testName, patched.GetName())
+	require.Equal(t, testNamespace, patched.GetNamespace())
+	require.Equal(t, testDoc.Annotations, patched.GetAnnotations())
+	require.Equal(t, testDoc.Data["test"], patched.Object["spec"].(map[string]any)["data"].(map[string]any)["test"])
+}
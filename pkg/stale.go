@@ -0,0 +1,90 @@
+package seeker
+
+import (
+	"context"
+	"time"
+
+	log "log/slog"
+
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+const (
+	// LastSuccessfulFetchAnnotation holds the time the stored data was last
+	// fetched from the gardens.
+	LastSuccessfulFetchAnnotation = "gardener-syncer.kyma-project.io/last-successful-fetch"
+	// StaleAnnotation is "true" if the fetches from the gardens failed since
+	// the stored data was fetched, the data is kept as the last known good.
+	StaleAnnotation = "gardener-syncer.kyma-project.io/stale"
+)
+
+// isStale returns true if the document is marked as stale.
+func isStale(doc Document) bool {
+	return doc.Annotations[StaleAnnotation] == "true"
+}
+
+// fetchedBefore returns true if the document was last fetched before the
+// time, or the fetch time is unknown.
+func fetchedBefore(doc Document, t time.Time) bool {
+	fetched, err := time.Parse(time.RFC3339, doc.Annotations[LastSuccessfulFetchAnnotation])
+	return err != nil || fetched.Before(t)
+}
+
+// ReportFetchFailures builds a fetch that reports its failures, a canceled
+// fetch did not fail and is not reported.
+func ReportFetchFailures(fetch FetchSeeds, report FailureReport) FetchSeeds {
+	return func(ctx context.Context) (types.Providers, error) {
+		providers, err := fetch(ctx)
+		if err != nil && !canceled(ctx) {
+			report(reportContext(ctx), err)
+		}
+		return providers, err
+	}
+}
+
+type MarkStaleOpts struct {
+	Timeout time.Duration
+	Sink
+}
+
+// BuildMarkStaleFn builds a report marking the stored data as stale, it
+// keeps the data and the annotations describing it. Nothing is marked if
+// nothing is stored yet. The marker is removed by the next store. A failure
+// is logged, the data keeps its last known state.
+func BuildMarkStaleFn(opts MarkStaleOpts) FailureReport {
+	return func(ctx context.Context, _ error) {
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+
+		logger := log.With("sink", opts.Sink.String())
+		stored, err := opts.Sink.Read(ctx)
+		if err != nil {
+			logger.With("error", err).Warn("unable to mark stored data as stale")
+			return
+		}
+
+		if len(stored.Data) == 0 || isStale(stored) {
+			return
+		}
+
+		// only the annotations written by the store are written back, the
+		// other ones are owned by other field managers
+		annotations := map[string]string{StaleAnnotation: "true"}
+		for _, key := range []string{
+			ContentHashAnnotation,
+			LastChangeAnnotation,
+			LastSyncAnnotation,
+			LastSuccessfulFetchAnnotation,
+		} {
+			if value, found := stored.Annotations[key]; found {
+				annotations[key] = value
+			}
+		}
+
+		if err := opts.Sink.Write(ctx, Document{Annotations: annotations, Data: stored.Data}); err != nil {
+			logger.With("error", err).Warn("unable to mark stored data as stale")
+			return
+		}
+		logger.Warn("stored data marked as stale")
+	}
+}
@@ -0,0 +1,162 @@
+package seeker_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/reader"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+var errFetchStaleTest = fmt.Errorf("fetch stale test")
+
+func TestBuildMarkStaleFn(t *testing.T) {
+	testCases := []struct {
+		name        string
+		stored      *seeker.Document
+		expectedDoc seeker.Document
+	}{
+		{
+			name: "OK1: stored data marked as stale",
+			stored: &seeker.Document{
+				Annotations: map[string]string{
+					seeker.ContentHashAnnotation:         "test-hash",
+					seeker.LastSuccessfulFetchAnnotation: "2024-01-01T00:00:00Z",
+					"test-annotation":                    "test",
+				},
+				Data: testDoc.Data,
+			},
+			expectedDoc: seeker.Document{
+				Annotations: map[string]string{
+					seeker.ContentHashAnnotation:         "test-hash",
+					seeker.LastSuccessfulFetchAnnotation: "2024-01-01T00:00:00Z",
+					seeker.StaleAnnotation:               "true",
+				},
+				Data: testDoc.Data,
+			},
+		},
+		{
+			name:        "OK2: nothing stored",
+			expectedDoc: seeker.Document{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			sink := seeker.FileSink{Path: filepath.Join(t.TempDir(), "cache.yaml"), Format: seeker.FileFormatYAML}
+			if testCase.stored != nil {
+				require.NoError(t, sink.Write(context.Background(), *testCase.stored))
+			}
+			markStale := seeker.BuildMarkStaleFn(seeker.MarkStaleOpts{Sink: sink, Timeout: time.Second})
+
+			// WHEN
+			markStale(context.Background(), errFetchStaleTest)
+
+			// THEN
+			doc, err := sink.Read(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedDoc, doc)
+		})
+	}
+}
+
+func TestReportFetchFailures(t *testing.T) {
+	testCases := []struct {
+		name           string
+		fetchErr       error
+		expectedReport error
+	}{
+		{
+			name: "OK1: fetched",
+		},
+		{
+			name:           "ERR1: fetch failure reported",
+			fetchErr:       errFetchStaleTest,
+			expectedReport: errFetchStaleTest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			var reported error
+			fetch := seeker.ReportFetchFailures(func(context.Context) (types.Providers, error) {
+				return testProviderRegions, testCase.fetchErr
+			}, func(_ context.Context, err error) {
+				reported = err
+			})
+
+			// WHEN
+			_, err := fetch(context.Background())
+
+			// THEN
+			require.ErrorIs(t, err, testCase.expectedReport)
+			require.Equal(t, testCase.expectedReport, reported)
+		})
+	}
+}
+
+func TestBuildStoreFn_FetchRefreshInterval(t *testing.T) {
+	const maxAge = time.Hour
+
+	testCases := []struct {
+		name                 string
+		fetchRefreshInterval time.Duration
+		expectedFresh        bool
+	}{
+		{
+			name:                 "OK1: unchanged data refreshed",
+			fetchRefreshInterval: maxAge / 2,
+			expectedFresh:        true,
+		},
+		{
+			name: "OK2: unchanged data not refreshed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			sink := seeker.FileSink{Path: filepath.Join(t.TempDir(), "cache.yaml"), Format: seeker.FileFormatYAML}
+			store := seeker.BuildStoreFn(seeker.StoreOpts{
+				Sink:                 sink,
+				Convert:              seeker.ToConfigMap,
+				Timeout:              time.Second,
+				FetchRefreshInterval: testCase.fetchRefreshInterval,
+			})
+			require.NoError(t, store(context.Background(), testProviderRegions))
+
+			for range 3 {
+				// GIVEN
+				age(t, sink, maxAge)
+
+				// WHEN
+				err := store(context.Background(), testProviderRegions)
+
+				// THEN
+				require.NoError(t, err)
+				cache, err := reader.Read(context.Background(), sink)
+				require.NoError(t, err)
+				require.Equal(t, testCase.expectedFresh, cache.FreshWithin(maxAge))
+			}
+		})
+	}
+}
+
+// age moves the last successful fetch of the stored document back by the
+// duration.
+func age(t *testing.T, sink seeker.Sink, duration time.Duration) {
+	doc, err := sink.Read(context.Background())
+	require.NoError(t, err)
+
+	fetched, err := time.Parse(time.RFC3339, doc.Annotations[seeker.LastSuccessfulFetchAnnotation])
+	require.NoError(t, err)
+	doc.Annotations[seeker.LastSuccessfulFetchAnnotation] = fetched.Add(-duration).Format(time.RFC3339)
+	require.NoError(t, sink.Write(context.Background(), doc))
+}
@@ -1,15 +1,21 @@
 package seeker
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
 	"time"
 
 	log "log/slog"
 
 	"github.com/kyma-project/gardener-syncer/pkg/types"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 )
 
 var FieldManagerName = "gardener-syncer"
@@ -18,14 +24,38 @@ type Patch func(context.Context, client.Object, client.Patch, ...client.PatchOpt
 
 type Get func(context.Context, client.ObjectKey, client.Object, ...client.GetOption) error
 
-type Store func(types.Providers) error
+type Store func(context.Context, types.Providers) error
 
 type StoreOpts struct {
 	Timeout time.Duration
-	Key     client.ObjectKey
+	// Sink is where the data is stored, it is the config map with the Key,
+	// read with Get and written with Patch if not set.
+	Sink
+	Key client.ObjectKey
 	Patch
 	Get
 	Convert[types.Providers, map[string]string]
+	// Guard is optional, it is checked against the stored document before
+	// it is replaced.
+	Guard
+	// DryRun prints the diff against the stored document and the result
+	// of the dry-run write to Out instead of storing the data, also if the
+	// Guard rejects it.
+	DryRun bool
+	Out    io.Writer
+	// RefreshLastSync writes the document even if the data did not change,
+	// to refresh the LastSyncAnnotation and LastSuccessfulFetchAnnotation.
+	// Unchanged data is not written by default, unless it is marked as
+	// stale.
+	RefreshLastSync bool
+	// FetchRefreshInterval writes the document even if the data did not
+	// change once its LastSuccessfulFetchAnnotation is older than the
+	// interval, so the readers can tell unchanged data from stale data.
+	// 0 disables it.
+	FetchRefreshInterval time.Duration
+	// Record is optional, it records the rejected stores, the stored
+	// regions and the regions added and removed.
+	Record Recorder
 }
 
 func logWithDuration(startTime time.Time) {
@@ -34,34 +64,157 @@ func logWithDuration(startTime time.Time) {
 }
 
 func BuildStoreFn(opts StoreOpts) Store {
-	return func(data types.Providers) (err error) {
-		ctx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
+	sink := opts.Sink
+	if sink == nil {
+		sink = ConfigMapSink{Key: opts.Key, Get: opts.Get, Patch: opts.Patch}
+	}
+
+	return func(syncCtx context.Context, data types.Providers) (err error) {
+		// the events are recorded with the context of the sync, they have
+		// their own timeout
+		ctx, cancel := context.WithTimeout(syncCtx, opts.Timeout)
 		defer cancel()
 
-		var cm corev1.ConfigMap
+		var stored Document
 		fetch := func() error {
-			log.With("config-map", opts.Key).Info("fetching")
+			log.With("sink", sink.String()).Info("fetching")
 			defer logWithDuration(time.Now())
-			return opts.Get(ctx, opts.Key, &cm)
+			stored, err = sink.Read(ctx)
+			return err
 		}
 
-		if err = fetch(); err != nil && !errors.IsNotFound(err) {
+		if err = fetch(); err != nil {
 			return err
 		}
 
-		cm.Name = opts.Key.Name
-		cm.Namespace = opts.Key.Namespace
-		cm.Data, err = opts.Convert(data)
-		cm.TypeMeta.Kind = "ConfigMap"
-		cm.TypeMeta.APIVersion = "v1"
-		cm.ManagedFields = nil
+		var guardErr error
+		if opts.Guard != nil {
+			guardErr = opts.Guard(stored, data)
+		}
+		if guardErr != nil && !opts.DryRun {
+			if opts.Record != nil && errors.Is(guardErr, ErrStoreRejected) {
+				opts.Record(syncCtx, corev1.EventTypeWarning, EventReasonStoreRejected, guardErr.Error())
+			}
+			return guardErr
+		}
 
+		converted, err := opts.Convert(data)
 		if err != nil {
 			return err
 		}
 
-		return opts.Patch(ctx, &cm, client.Apply, &client.PatchOptions{
-			FieldManager: FieldManagerName,
-		})
+		now := time.Now()
+		doc, changed := annotate(stored, converted, now)
+		if opts.DryRun {
+			// the diff is printed even if the guard rejects the data, the
+			// rejection is reported instead of the drift then
+			err := dryRun(ctx, sink, opts.Out, stored, data, doc)
+			if guardErr != nil && (err == nil || errors.Is(err, ErrDriftDetected)) {
+				return guardErr
+			}
+			return err
+		}
+
+		// the annotations are written again if they do not describe the
+		// stored data, e.g. the data was stored by an older version
+		outdated := stored.Annotations[ContentHashAnnotation] != doc.Annotations[ContentHashAnnotation]
+		refresh := opts.RefreshLastSync || opts.FetchRefreshInterval > 0 && fetchedBefore(stored, now.Add(-opts.FetchRefreshInterval))
+		if changed || outdated || refresh || isStale(stored) {
+			if err := sink.Write(ctx, doc); err != nil {
+				return err
+			}
+		} else {
+			log.With("sink", sink.String()).Info("data unchanged, skipping")
+		}
+
+		if opts.Record != nil {
+			recordStored(syncCtx, opts.Record, stored, data)
+		}
+		return nil
+	}
+}
+
+// dryRun prints what storing the data would change, without persisting it.
+// It fails with ErrDriftDetected if the stored data would change. The output
+// is written at once, so the outputs of the targets stored concurrently are
+// not mixed.
+func dryRun(ctx context.Context, sink Sink, out io.Writer, stored Document, data types.Providers, doc Document) error {
+	if out == nil {
+		out = os.Stdout
+	}
+
+	storedProviders, err := decodeDocument(stored)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s\n%s", sink, FormatDiff(Diff(storedProviders, data)))
+
+	result, err := sink.DryRun(ctx, doc)
+	if err != nil {
+		return err
+	}
+
+	resultData, err := yaml.Marshal(result)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(&buf, "--- dry-run result\n%s", resultData)
+	if _, err := out.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	if !maps.Equal(stored.Data, doc.Data) {
+		return fmt.Errorf("%w: %s", ErrDriftDetected, sink)
+	}
+	return nil
+}
+
+func applyConfigMap(ctx context.Context, patch Patch, key client.ObjectKey, cm *corev1.ConfigMap, opts ...client.PatchOption) error {
+	cm.Name = key.Name
+	cm.Namespace = key.Namespace
+	cm.TypeMeta.Kind = "ConfigMap"
+	cm.TypeMeta.APIVersion = "v1"
+	cm.ManagedFields = nil
+
+	opts = append(opts, &client.PatchOptions{
+		FieldManager: FieldManagerName,
+	})
+	return patch(ctx, cm, client.Apply, opts...)
+}
+
+type VerdictStore func(context.Context, []SeedVerdict) error
+
+type VerdictStoreOpts struct {
+	Timeout time.Duration
+	Key     client.ObjectKey
+	Patch
+}
+
+// BuildVerdictStoreFn builds a function that stores the seed verdicts in a
+// config map, next to the seeds cache.
+func BuildVerdictStoreFn(opts VerdictStoreOpts) VerdictStore {
+	return func(ctx context.Context, verdicts []SeedVerdict) error {
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+
+		data, err := VerdictsToConfigMap(verdicts)
+		if err != nil {
+			return err
+		}
+
+		return applyConfigMap(ctx, opts.Patch, opts.Key, &corev1.ConfigMap{Data: data})
+	}
+}
+
+// ReportTo builds a report that stores the verdicts in the verdict store.
+// The verdicts are informative, so a failure is logged and does not fail
+// the sync.
+func ReportTo(store VerdictStore) Report {
+	return func(ctx context.Context, verdicts []SeedVerdict) {
+		if err := store(ctx, verdicts); err != nil {
+			log.With("error", err).Warn("unable to store seed verdicts")
+		}
 	}
 }
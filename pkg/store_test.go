@@ -3,6 +3,8 @@ package seeker_test
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
 
 	seeker "github.com/kyma-project/gardener-syncer/pkg"
@@ -157,7 +159,7 @@ func TestBuildStoreFn(t *testing.T) {
 			})
 
 			// WHEN
-			err := store(testCase.data2Store)
+			err := store(context.Background(), testCase.data2Store)
 
 			// THEN
 			if testCase.expectedErr == nil {
@@ -171,3 +173,93 @@ func TestBuildStoreFn(t *testing.T) {
 		})
 	}
 }
+
+// writeCounter counts the writes, so tests can check that the output of a
+// target is written at once.
+type writeCounter struct {
+	strings.Builder
+	writes int
+}
+
+func (w *writeCounter) Write(p []byte) (int, error) {
+	w.writes++
+	return w.Builder.Write(p)
+}
+
+func TestBuildStoreFn_DryRun(t *testing.T) {
+	testCases := []struct {
+		title       string
+		data2Store  types.Providers
+		guard       seeker.Guard
+		expectedOut []string
+		expectedErr error
+	}{
+		{
+			title:       "no drift",
+			data2Store:  testProviderRegions,
+			expectedOut: []string{"no region changes", "dry-run result"},
+		},
+		{
+			title: "drift",
+			data2Store: types.Providers{
+				"test": {SeedRegions: []string{"me", "plz", "more"}},
+			},
+			expectedOut: []string{"test:\n+ more\n", "dry-run result"},
+			expectedErr: seeker.ErrDriftDetected,
+		},
+		{
+			title:       "drift rejected by the guard",
+			data2Store:  types.Providers{},
+			guard:       seeker.BuildShrinkGuardFn(seeker.ShrinkGuardOpts{MaxShrinkPercent: 50}),
+			expectedOut: []string{"test:\n- me\n- plz\n", "dry-run result"},
+			expectedErr: seeker.ErrStoreRejected,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.title, func(t *testing.T) {
+			// GIVEN
+			stored := testCM
+			stored.Data = map[string]string{
+				"test": testData["test"],
+			}
+			var dryRun bool
+			var out writeCounter
+			store := seeker.BuildStoreFn(seeker.StoreOpts{
+				Key: client.ObjectKey{Name: testName, Namespace: testNamespace},
+				Get: func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					*obj.(*corev1.ConfigMap) = stored
+					return nil
+				},
+				Patch: func(_ context.Context, _ client.Object, _ client.Patch, opts ...client.PatchOption) error {
+					dryRun = slices.Contains(opts, client.PatchOption(client.DryRunAll))
+					return nil
+				},
+				Convert: seeker.ToConfigMap,
+				Guard:   testCase.guard,
+				DryRun:  true,
+				Out:     &out,
+			})
+
+			// WHEN
+			err := store(context.Background(), testCase.data2Store)
+
+			// THEN
+			require.True(t, dryRun)
+			for _, expected := range testCase.expectedOut {
+				require.Contains(t, out.String(), expected)
+			}
+			require.Equal(t, 1, out.writes)
+
+			// THEN
+			if testCase.expectedErr == nil {
+				require.NoError(t, err)
+			}
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+			}
+		})
+	}
+}
@@ -1,18 +1,80 @@
 package seeker
 
-type Sync func() error
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrCanceled is returned by the syncs stopped by the cancellation of their
+// context, e.g. on SIGTERM. A canceled sync did not fail.
+var ErrCanceled = errors.New("sync canceled")
+
+type Sync func(context.Context) error
 
 func BuildSyncFn(store Store, fetch FetchSeeds) Sync {
-	return func() (err error) {
-		providerRegions, err := fetch()
+	return func(ctx context.Context) (err error) {
+		defer func() {
+			if err != nil && canceled(ctx) {
+				err = fmt.Errorf("%w: %w", ErrCanceled, err)
+			}
+		}()
+
+		providerRegions, err := fetch(ctx)
 		if err != nil {
 			return err
 		}
 
-		if err := store(providerRegions); err != nil {
+		if err := store(ctx, providerRegions); err != nil {
 			return err
 		}
 
 		return nil
 	}
 }
+
+// canceled returns true if the context was canceled. A context past its
+// deadline is not canceled, the step it was passed to timed out.
+func canceled(ctx context.Context) bool {
+	return errors.Is(ctx.Err(), context.Canceled)
+}
+
+// WithRunTimeout builds a sync that fails if it does not finish within the
+// timeout, the timeouts of its steps are derived from it. Zero disables it.
+func WithRunTimeout(sync Sync, timeout time.Duration) Sync {
+	if timeout == 0 {
+		return sync
+	}
+
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return sync(ctx)
+	}
+}
+
+// FailureReport is called with the context and the error of a failed sync.
+type FailureReport func(context.Context, error)
+
+// ReportFailures builds a sync that reports its failures, the canceled
+// syncs did not fail and are not reported.
+func ReportFailures(sync Sync, report FailureReport) Sync {
+	return func(ctx context.Context) error {
+		err := sync(ctx)
+		if err != nil && !errors.Is(err, ErrCanceled) {
+			report(reportContext(ctx), err)
+		}
+		return err
+	}
+}
+
+// reportContext returns the context the failure of a step run with the
+// context is reported with. A step past its deadline failed, its failure is
+// reported without the deadline, the report applies its own timeout.
+func reportContext(ctx context.Context) context.Context {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return context.WithoutCancel(ctx)
+	}
+	return ctx
+}
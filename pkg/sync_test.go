@@ -1,8 +1,11 @@
 package seeker_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	seeker "github.com/kyma-project/gardener-syncer/pkg"
 	"github.com/kyma-project/gardener-syncer/pkg/types"
@@ -45,7 +48,7 @@ func TestBuildSyncFn(t *testing.T) {
 			sync := seeker.BuildSyncFn(testCase.store, testCase.fetch)
 
 			// WHEN
-			err := sync()
+			err := sync(context.Background())
 
 			// THEN
 			if testCase.expectedErr == nil {
@@ -61,25 +64,80 @@ func TestBuildSyncFn(t *testing.T) {
 }
 
 func buildFetchSeedsWithError(err error) seeker.FetchSeeds {
-	return func() (types.Providers, error) {
+	return func(context.Context) (types.Providers, error) {
 		return nil, err
 	}
 }
 
 func buildFetch(out types.Providers) seeker.FetchSeeds {
-	return func() (types.Providers, error) {
+	return func(context.Context) (types.Providers, error) {
 		return out, nil
 	}
 }
 
 func buildStoreWithError(err error) seeker.Store {
-	return func(regions types.Providers) error {
+	return func(_ context.Context, regions types.Providers) error {
 		return err
 	}
 }
 
 func buildStore() seeker.Store {
-	return func(pr types.Providers) error {
+	return func(_ context.Context, pr types.Providers) error {
 		return nil
 	}
 }
+
+func buildFetchBlocked() seeker.FetchSeeds {
+	return func(ctx context.Context) (types.Providers, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+}
+
+func TestBuildSyncFn_Context(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name             string
+		ctx              context.Context
+		runTimeout       time.Duration
+		expectedErr      error
+		expectedCanceled bool
+		expectedReported bool
+	}{
+		{
+			name:             "canceled",
+			ctx:              canceled,
+			expectedErr:      context.Canceled,
+			expectedCanceled: true,
+		},
+		{
+			name:             "run timed out",
+			ctx:              context.Background(),
+			runTimeout:       time.Millisecond,
+			expectedErr:      context.DeadlineExceeded,
+			expectedReported: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			var reported, reportCtxErr error
+			sync := seeker.WithRunTimeout(seeker.ReportFailures(
+				seeker.BuildSyncFn(buildStore(), buildFetchBlocked()),
+				func(ctx context.Context, err error) { reported, reportCtxErr = err, ctx.Err() },
+			), testCase.runTimeout)
+
+			// WHEN
+			err := sync(testCase.ctx)
+
+			// THEN
+			require.ErrorIs(t, err, testCase.expectedErr)
+			require.Equal(t, testCase.expectedCanceled, errors.Is(err, seeker.ErrCanceled))
+			require.Equal(t, testCase.expectedReported, reported != nil)
+			require.NoError(t, reportCtxErr)
+		})
+	}
+}
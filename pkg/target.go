@@ -0,0 +1,49 @@
+package seeker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	log "log/slog"
+
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+)
+
+// TargetStore is the store of one of the targets the seeds cache is written to.
+type TargetStore struct {
+	Target string
+	Store
+}
+
+// BuildMultiTargetStoreFn builds a function that stores the data in all the
+// targets concurrently. The result of every target is logged, a failed
+// target does not stop the others, but it fails the store.
+func BuildMultiTargetStoreFn(targets []TargetStore) Store {
+	return func(ctx context.Context, data types.Providers) error {
+		errs := make([]error, len(targets))
+
+		var wg sync.WaitGroup
+		for i, target := range targets {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = target.Store(ctx, data)
+			}()
+		}
+		wg.Wait()
+
+		for i, err := range errs {
+			logger := log.With("target", targets[i].Target)
+			if err != nil {
+				logger.With("error", err).Error("store failed")
+				errs[i] = fmt.Errorf("target %s: %w", targets[i].Target, err)
+				continue
+			}
+			logger.Info("stored")
+		}
+
+		return errors.Join(errs...)
+	}
+}
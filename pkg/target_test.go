@@ -0,0 +1,67 @@
+package seeker_test
+
+import (
+	"context"
+	"testing"
+
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMultiTargetStoreFn(t *testing.T) {
+	testCases := []struct {
+		name          string
+		targets       []seeker.TargetStore
+		expectedErr   error
+		expectedInErr []string
+	}{
+		{
+			name: "OK",
+			targets: []seeker.TargetStore{
+				{Target: "test-target1", Store: buildStore()},
+				{Target: "test-target2", Store: buildStore()},
+			},
+		},
+		{
+			name: "one target failed",
+			targets: []seeker.TargetStore{
+				{Target: "test-target1", Store: buildStoreWithError(errStoreFailedTest)},
+				{Target: "test-target2", Store: buildStore()},
+			},
+			expectedErr:   errStoreFailedTest,
+			expectedInErr: []string{"test-target1"},
+		},
+		{
+			name: "all targets failed",
+			targets: []seeker.TargetStore{
+				{Target: "test-target1", Store: buildStoreWithError(errStoreFailedTest)},
+				{Target: "test-target2", Store: buildStoreWithError(errStoreFailedTest)},
+			},
+			expectedErr:   errStoreFailedTest,
+			expectedInErr: []string{"test-target1", "test-target2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// GIVEN
+			store := seeker.BuildMultiTargetStoreFn(testCase.targets)
+
+			// WHEN
+			err := store(context.Background(), types.Providers{})
+
+			// THEN
+			if testCase.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			// THEN
+			require.ErrorIs(t, err, testCase.expectedErr)
+			for _, target := range testCase.expectedInErr {
+				require.ErrorContains(t, err, target)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// SchemaVersion is the version of the format the providers are stored in.
+type SchemaVersion string
+
+const (
+	// SchemaVersionKey is the key that holds the schema version next to the
+	// provider keys. Data without it is in SchemaV1, so it is not written for
+	// SchemaV1 and the consumers reading every key as a provider keep
+	// working.
+	SchemaVersionKey = "schemaVersion"
+
+	// SchemaV1 holds only the list of seed regions of every provider.
+	SchemaV1 SchemaVersion = "v1"
+	// SchemaV2 extends SchemaV1 with the details of every seed region.
+	SchemaV2 SchemaVersion = "v2"
+)
+
+var SchemaVersions = []SchemaVersion{SchemaV1, SchemaV2}
+
+var ErrUnsupportedSchema = fmt.Errorf("unsupported schema version")
+
+var ErrReservedProvider = fmt.Errorf("provider name is reserved")
+
+func (v SchemaVersion) Validate() error {
+	if !slices.Contains(SchemaVersions, v) {
+		return fmt.Errorf("%w: %s", ErrUnsupportedSchema, v)
+	}
+	return nil
+}
+
+type providerInfoV1 struct {
+	SeedRegions []string `json:"seedRegions"`
+}
+
+func encodeV1(info ProviderInfo) any {
+	return providerInfoV1{SeedRegions: info.SeedRegions}
+}
+
+func encodeV2(info ProviderInfo) any {
+	return info
+}
+
+var encoders = map[SchemaVersion]func(ProviderInfo) any{
+	SchemaV1: encodeV1,
+	SchemaV2: encodeV2,
+}
+
+// Encode converts the providers into key-value data in the given schema
+// version, one YAML document per provider and the schema version key from
+// SchemaV2 on. The providers are sorted first, so the same providers always
+// result in the same data byte for byte.
+func Encode(version SchemaVersion, providers Providers) (map[string]string, error) {
+	if err := version.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, found := providers[SchemaVersionKey]; found {
+		return nil, fmt.Errorf("%w: %s", ErrReservedProvider, SchemaVersionKey)
+	}
+
+	encode := encoders[version]
+	result := map[string]string{}
+	if version != SchemaV1 {
+		result[SchemaVersionKey] = string(version)
+	}
+	for k, v := range providers.Sorted() {
+		data, err := yaml.Marshal(encode(v))
+		if err != nil {
+			return nil, err
+		}
+		result[k] = strings.TrimRight(string(data), "\n")
+	}
+	return result, nil
+}
+
+// Decode is the inverse of Encode, it accepts data in any supported schema
+// version.
+func Decode(data map[string]string) (Providers, SchemaVersion, error) {
+	version := SchemaV1
+	if value, found := data[SchemaVersionKey]; found {
+		version = SchemaVersion(value)
+	}
+
+	if err := version.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	result := Providers{}
+	for k, v := range data {
+		if k == SchemaVersionKey {
+			continue
+		}
+
+		var info ProviderInfo
+		if err := yaml.Unmarshal([]byte(v), &info); err != nil {
+			return nil, "", fmt.Errorf("invalid provider '%s': %w", k, err)
+		}
+		result[k] = info
+	}
+	return result, version, nil
+}
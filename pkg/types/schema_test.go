@@ -0,0 +1,198 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+var testDetailedProviders = types.Providers{
+	testProviderName: {
+		SeedRegions: []string{testRegionName},
+		Regions: map[string]types.RegionInfo{
+			testRegionName: {
+				SeedCount: 1,
+				Seeds: []types.SeedInfo{
+					{Name: testSeed},
+				},
+			},
+		},
+	},
+}
+
+func TestEncode(t *testing.T) {
+	testCases := []struct {
+		name        string
+		version     types.SchemaVersion
+		expected    map[string]string
+		expectedErr error
+	}{
+		{
+			name:    "v1",
+			version: types.SchemaV1,
+			expected: map[string]string{
+				testProviderName: `seedRegions:
+- test-region`,
+			},
+		},
+		{
+			name:    "v2",
+			version: types.SchemaV2,
+			expected: map[string]string{
+				types.SchemaVersionKey: "v2",
+				testProviderName: `regions:
+  test-region:
+    seedCount: 1
+    seeds:
+    - name: test-seed
+seedRegions:
+- test-region`,
+			},
+		},
+		{
+			name:        "unsupported",
+			version:     "v0",
+			expectedErr: types.ErrUnsupportedSchema,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual, err := types.Encode(testCase.version, testDetailedProviders)
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
+
+func TestEncode_ReservedProvider(t *testing.T) {
+	// WHEN
+	_, err := types.Encode(types.SchemaV1, types.Providers{
+		types.SchemaVersionKey: types.ProviderInfo{SeedRegions: []string{"test-region"}},
+	})
+
+	// THEN
+	require.ErrorIs(t, err, types.ErrReservedProvider)
+}
+
+func TestDecode(t *testing.T) {
+	testCases := []struct {
+		name            string
+		data            map[string]string
+		expected        types.Providers
+		expectedVersion types.SchemaVersion
+		expectedErr     error
+	}{
+		{
+			name: "no schema version",
+			data: map[string]string{
+				testProviderName: `seedRegions:
+- test-region`,
+			},
+			expected: types.Providers{
+				testProviderName: {SeedRegions: []string{testRegionName}},
+			},
+			expectedVersion: types.SchemaV1,
+		},
+		{
+			name: "v2",
+			data: map[string]string{
+				types.SchemaVersionKey: "v2",
+				testProviderName: `regions:
+  test-region:
+    seedCount: 1
+    seeds:
+    - name: test-seed
+seedRegions:
+- test-region`,
+			},
+			expected:        testDetailedProviders,
+			expectedVersion: types.SchemaV2,
+		},
+		{
+			name: "unsupported",
+			data: map[string]string{
+				types.SchemaVersionKey: "v0",
+			},
+			expectedErr: types.ErrUnsupportedSchema,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual, version, err := types.Decode(testCase.data)
+
+			// THEN
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+				return
+			}
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, actual)
+			require.Equal(t, testCase.expectedVersion, version)
+		})
+	}
+}
+
+func TestEncode_Sorted(t *testing.T) {
+	// GIVEN
+	added, reversed := types.Providers{}, types.Providers{}
+	seeds := []struct {
+		region string
+		seed   types.SeedInfo
+	}{
+		{region: "eu-west-1", seed: types.SeedInfo{Name: "seed-b", Zones: []string{"eu-west-1b", "eu-west-1a"}}},
+		{region: "eu-central-1", seed: types.SeedInfo{Name: "seed-c", Garden: "garden-b"}},
+		{region: "eu-central-1", seed: types.SeedInfo{Name: "seed-a", Garden: "garden-a"}},
+	}
+	for i := range seeds {
+		added.AddSeed(testProviderName, seeds[i].region, seeds[i].seed)
+		reversed.AddSeed(testProviderName, seeds[len(seeds)-1-i].region, seeds[len(seeds)-1-i].seed)
+	}
+
+	// WHEN
+	actual, err := types.Encode(types.SchemaV2, added)
+	require.NoError(t, err)
+	actualReversed, err := types.Encode(types.SchemaV2, reversed)
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, actual, actualReversed)
+	require.Equal(t, `regions:
+  eu-central-1:
+    gardens:
+    - garden-a
+    - garden-b
+    seedCount: 2
+    seeds:
+    - garden: garden-a
+      name: seed-a
+    - garden: garden-b
+      name: seed-c
+  eu-west-1:
+    seedCount: 1
+    seeds:
+    - name: seed-b
+      zones:
+      - eu-west-1a
+      - eu-west-1b
+    zones:
+    - eu-west-1a
+    - eu-west-1b
+seedRegions:
+- eu-central-1
+- eu-west-1`, actual[testProviderName])
+}
@@ -1,9 +1,36 @@
 package types
 
-import "slices"
+import (
+	"cmp"
+	"slices"
+)
+
+// SeedInfo describes a single seed in a region.
+type SeedInfo struct {
+	Name string `json:"name"`
+	// Garden is the name of the garden the seed belongs to, if known.
+	Garden string            `json:"garden,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Zones  []string          `json:"zones,omitempty"`
+	// Capacity is the total number of shoots the seed can host, if known.
+	Capacity *int64 `json:"capacity,omitempty"`
+	// Allocatable is the number of shoots available for scheduling, if known.
+	Allocatable *int64 `json:"allocatable,omitempty"`
+}
+
+// RegionInfo describes all the seeds available in a region.
+type RegionInfo struct {
+	SeedCount int      `json:"seedCount"`
+	Zones     []string `json:"zones,omitempty"`
+	// Gardens are the names of the gardens the seeds come from, if known.
+	Gardens []string   `json:"gardens,omitempty"`
+	Seeds   []SeedInfo `json:"seeds"`
+}
 
 type ProviderInfo struct {
 	SeedRegions []string `json:"seedRegions"`
+	// Regions is optional, it holds the details of every seed region.
+	Regions map[string]RegionInfo `json:"regions,omitempty"`
 }
 
 type Providers map[string]ProviderInfo
@@ -17,3 +44,92 @@ func (s *Providers) Add(provider, regionName string) {
 	providerInfo.SeedRegions = append(providerInfo.SeedRegions, regionName)
 	(*s)[provider] = providerInfo
 }
+
+// AddSeed adds the region and records the details of the seed in it.
+func (s *Providers) AddSeed(provider, regionName string, seed SeedInfo) {
+	s.Add(provider, regionName)
+
+	providerInfo := (*s)[provider]
+	if providerInfo.Regions == nil {
+		providerInfo.Regions = map[string]RegionInfo{}
+	}
+
+	regionInfo := providerInfo.Regions[regionName]
+	regionInfo.Seeds = append(regionInfo.Seeds, seed)
+	regionInfo.SeedCount = len(regionInfo.Seeds)
+	for _, zone := range seed.Zones {
+		if !slices.Contains(regionInfo.Zones, zone) {
+			regionInfo.Zones = append(regionInfo.Zones, zone)
+		}
+	}
+
+	if seed.Garden != "" && !slices.Contains(regionInfo.Gardens, seed.Garden) {
+		regionInfo.Gardens = append(regionInfo.Gardens, seed.Garden)
+	}
+
+	providerInfo.Regions[regionName] = regionInfo
+	(*s)[provider] = providerInfo
+}
+
+// Merge adds all the regions and seed details of the other providers.
+func (s *Providers) Merge(other Providers) {
+	for provider, providerInfo := range other {
+		for _, regionName := range providerInfo.SeedRegions {
+			regionInfo, found := providerInfo.Regions[regionName]
+			if !found {
+				s.Add(provider, regionName)
+				continue
+			}
+
+			for _, seed := range regionInfo.Seeds {
+				s.AddSeed(provider, regionName, seed)
+			}
+		}
+	}
+}
+
+// RegionCount returns the number of seed regions of all the providers.
+func (s Providers) RegionCount() int {
+	var out int
+	for _, providerInfo := range s {
+		out += len(providerInfo.SeedRegions)
+	}
+	return out
+}
+
+// Sorted returns a copy of the providers with the regions, zones, gardens
+// and seeds sorted, so that equal providers are always serialized the same
+// way.
+func (s Providers) Sorted() Providers {
+	out := make(Providers, len(s))
+	for provider, providerInfo := range s {
+		sorted := ProviderInfo{
+			SeedRegions: sortedCopy(providerInfo.SeedRegions),
+		}
+
+		if providerInfo.Regions != nil {
+			sorted.Regions = make(map[string]RegionInfo, len(providerInfo.Regions))
+			for regionName, regionInfo := range providerInfo.Regions {
+				regionInfo.Zones = sortedCopy(regionInfo.Zones)
+				regionInfo.Gardens = sortedCopy(regionInfo.Gardens)
+				regionInfo.Seeds = slices.Clone(regionInfo.Seeds)
+				for i := range regionInfo.Seeds {
+					regionInfo.Seeds[i].Zones = sortedCopy(regionInfo.Seeds[i].Zones)
+				}
+				slices.SortFunc(regionInfo.Seeds, func(a, b SeedInfo) int {
+					return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Garden, b.Garden))
+				})
+				sorted.Regions[regionName] = regionInfo
+			}
+		}
+
+		out[provider] = sorted
+	}
+	return out
+}
+
+func sortedCopy(s []string) []string {
+	out := slices.Clone(s)
+	slices.Sort(out)
+	return out
+}
@@ -60,3 +60,88 @@ func TestProviderRegionSeed_Add(t *testing.T) {
 		})
 	}
 }
+
+func TestProviders_AddSeed(t *testing.T) {
+	// GIVEN
+	providers := types.Providers{}
+
+	// WHEN
+	providers.AddSeed(testProviderName, testRegionName, types.SeedInfo{
+		Name:  testSeed,
+		Zones: []string{"a", "b"},
+	})
+	providers.AddSeed(testProviderName, testRegionName, types.SeedInfo{
+		Name:  "some-other-test-seed",
+		Zones: []string{"b", "c"},
+	})
+
+	// THEN
+	require.Equal(t, types.Providers{
+		testProviderName: {
+			SeedRegions: []string{testRegionName},
+			Regions: map[string]types.RegionInfo{
+				testRegionName: {
+					SeedCount: 2,
+					Zones:     []string{"a", "b", "c"},
+					Seeds: []types.SeedInfo{
+						{
+							Name:  testSeed,
+							Zones: []string{"a", "b"},
+						},
+						{
+							Name:  "some-other-test-seed",
+							Zones: []string{"b", "c"},
+						},
+					},
+				},
+			},
+		},
+	}, providers)
+}
+
+func TestProviders_Merge(t *testing.T) {
+	// GIVEN
+	providers := types.Providers{
+		testProviderName: {
+			SeedRegions: []string{testRegionName},
+		},
+	}
+
+	// WHEN
+	providers.Merge(types.Providers{
+		testProviderName: {
+			SeedRegions: []string{testRegionName, "some-other-test-region"},
+		},
+		"some-other-test-provider": {
+			SeedRegions: []string{testRegionName},
+			Regions: map[string]types.RegionInfo{
+				testRegionName: {
+					SeedCount: 1,
+					Gardens:   []string{"test-garden"},
+					Seeds: []types.SeedInfo{
+						{Name: testSeed, Garden: "test-garden"},
+					},
+				},
+			},
+		},
+	})
+
+	// THEN
+	require.Equal(t, types.Providers{
+		testProviderName: {
+			SeedRegions: []string{testRegionName, "some-other-test-region"},
+		},
+		"some-other-test-provider": {
+			SeedRegions: []string{testRegionName},
+			Regions: map[string]types.RegionInfo{
+				testRegionName: {
+					SeedCount: 1,
+					Gardens:   []string{"test-garden"},
+					Seeds: []types.SeedInfo{
+						{Name: testSeed, Garden: "test-garden"},
+					},
+				},
+			},
+		},
+	}, providers)
+}
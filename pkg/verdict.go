@@ -0,0 +1,181 @@
+package seeker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/kyma-project/gardener-syncer/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+type RejectionReason string
+
+const (
+	ReasonDeleted           RejectionReason = "deleted"
+	ReasonInvisible         RejectionReason = "invisible"
+	ReasonNoLastOperation   RejectionReason = "no-last-operation"
+	ReasonGardenletNotReady RejectionReason = "gardenlet-not-ready"
+	ReasonBackupNotReady    RejectionReason = "backup-not-ready"
+	ReasonConditionNotReady RejectionReason = "condition-not-ready"
+	ReasonLabelMismatch     RejectionReason = "label-mismatch"
+	ReasonTainted           RejectionReason = "tainted"
+	ReasonGardenletOutdated RejectionReason = "gardenlet-outdated"
+)
+
+var RejectionReasons = []RejectionReason{
+	ReasonDeleted,
+	ReasonInvisible,
+	ReasonNoLastOperation,
+	ReasonGardenletNotReady,
+	ReasonBackupNotReady,
+	ReasonConditionNotReady,
+	ReasonLabelMismatch,
+	ReasonTainted,
+	ReasonGardenletOutdated,
+}
+
+type Rejection struct {
+	Reason  RejectionReason `json:"reason"`
+	Message string          `json:"message"`
+	// Taint is the key of the taint the seed was rejected for, if any.
+	Taint string `json:"taint,omitempty"`
+}
+
+// SeedVerdict tells if the seed can be used and, if not, all the reasons it
+// was rejected for.
+type SeedVerdict struct {
+	Garden     string      `json:"garden,omitempty"`
+	Seed       string      `json:"seed"`
+	Provider   string      `json:"provider"`
+	Region     string      `json:"region"`
+	Rejections []Rejection `json:"rejections,omitempty"`
+	// Details are the seed details published in the detailed seeds cache.
+	Details types.SeedInfo `json:"-"`
+}
+
+func (v SeedVerdict) Usable() bool {
+	return len(v.Rejections) == 0
+}
+
+// Report receives the verdicts of all the fetched seeds, with the context
+// of the fetch.
+type Report func(context.Context, []SeedVerdict)
+
+// Reports combines several reports into one.
+func Reports(reports ...Report) Report {
+	return func(ctx context.Context, verdicts []SeedVerdict) {
+		for _, report := range reports {
+			report(ctx, verdicts)
+		}
+	}
+}
+
+func conditionRejection(seed *gardener_types.Seed, conditionType gardener_types.ConditionType, reason RejectionReason) *Rejection {
+	cond := v1beta1helper.GetCondition(seed.Status.Conditions, conditionType)
+	if cond == nil {
+		return &Rejection{
+			Reason:  reason,
+			Message: fmt.Sprintf("condition %s not found", conditionType),
+		}
+	}
+
+	if cond.Status != gardener_types.ConditionTrue {
+		return &Rejection{
+			Reason:  reason,
+			Message: fmt.Sprintf("condition %s is %s: %s", conditionType, cond.Status, cond.Message),
+		}
+	}
+
+	return nil
+}
+
+func shoots(resources corev1.ResourceList) *int64 {
+	quantity, found := resources[gardener_types.ResourceShoots]
+	if !found {
+		return nil
+	}
+
+	value := quantity.Value()
+	return &value
+}
+
+func toSeedInfo(seed *gardener_types.Seed) types.SeedInfo {
+	return types.SeedInfo{
+		Name:        seed.Name,
+		Labels:      seed.Labels,
+		Zones:       seed.Spec.Provider.Zones,
+		Capacity:    shoots(seed.Status.Capacity),
+		Allocatable: shoots(seed.Status.Allocatable),
+	}
+}
+
+var evaluateDefault = mustEvaluator(DefaultPolicy)
+
+func mustEvaluator(policy Policy) Evaluate {
+	out, err := policy.Evaluator()
+	if err != nil {
+		panic(fmt.Sprintf("invalid policy: %s", err))
+	}
+	return out
+}
+
+// EvaluateSeed evaluates the seed with the DefaultPolicy.
+func EvaluateSeed(seed *gardener_types.Seed) SeedVerdict {
+	return evaluateDefault(seed)
+}
+
+func EvaluateSeeds(seeds []gardener_types.Seed) []SeedVerdict {
+	return EvaluateSeedsWith(EvaluateSeed, seeds)
+}
+
+func EvaluateSeedsWith(evaluate Evaluate, seeds []gardener_types.Seed) []SeedVerdict {
+	out := make([]SeedVerdict, 0, len(seeds))
+	for i := range seeds {
+		out = append(out, evaluate(&seeds[i]))
+	}
+	return out
+}
+
+func logVerdicts(verdicts []SeedVerdict) {
+	for _, verdict := range verdicts {
+		if verdict.Usable() {
+			slog.Debug("seed accepted", "seedName", verdict.Seed)
+			continue
+		}
+
+		slog.Info("seed rejected",
+			"seedName", verdict.Seed,
+			"provider", verdict.Provider,
+			"region", verdict.Region,
+			"rejections", verdict.Rejections,
+		)
+	}
+}
+
+// key returns the unique key of the verdict, the seed name prefixed with the
+// garden name if there is one.
+func (v SeedVerdict) key() string {
+	if v.Garden == "" {
+		return v.Seed
+	}
+	return v.Garden + "." + v.Seed
+}
+
+// VerdictsToConfigMap converts the verdicts into config map data, one key
+// per seed.
+func VerdictsToConfigMap(verdicts []SeedVerdict) (map[string]string, error) {
+	result := map[string]string{}
+	for _, verdict := range verdicts {
+		data, err := yaml.Marshal(verdict)
+		if err != nil {
+			return nil, err
+		}
+		result[verdict.key()] = strings.TrimRight(string(data), "\n")
+	}
+	return result, nil
+}
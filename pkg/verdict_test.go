@@ -0,0 +1,130 @@
+package seeker_test
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seeker "github.com/kyma-project/gardener-syncer/pkg"
+	"github.com/stretchr/testify/require"
+)
+
+func reasons(verdict seeker.SeedVerdict) []seeker.RejectionReason {
+	var out []seeker.RejectionReason
+	for _, rejection := range verdict.Rejections {
+		out = append(out, rejection.Reason)
+	}
+	return out
+}
+
+func TestEvaluateSeed(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seed     gardener_types.Seed
+		expected []seeker.RejectionReason
+	}{
+		{
+			name: "in deletion",
+			seed: testSeedInDeletion,
+			expected: []seeker.RejectionReason{
+				seeker.ReasonDeleted,
+				seeker.ReasonInvisible,
+				seeker.ReasonNoLastOperation,
+				seeker.ReasonGardenletNotReady,
+			},
+		},
+		{
+			name: "not visible",
+			seed: testSeedNotVisible,
+			expected: []seeker.RejectionReason{
+				seeker.ReasonInvisible,
+				seeker.ReasonNoLastOperation,
+				seeker.ReasonGardenletNotReady,
+			},
+		},
+		{
+			name: "no last operation",
+			seed: testSeedNoLatOperation,
+			expected: []seeker.RejectionReason{
+				seeker.ReasonNoLastOperation,
+				seeker.ReasonGardenletNotReady,
+			},
+		},
+		{
+			name:     "no gardenlet ready condition",
+			seed:     testSeedNoSeedGardenletReady,
+			expected: []seeker.RejectionReason{seeker.ReasonGardenletNotReady},
+		},
+		{
+			name:     "gardenlet not ready",
+			seed:     testSeedGardenletReadyFalse,
+			expected: []seeker.RejectionReason{seeker.ReasonGardenletNotReady},
+		},
+		{
+			name:     "no backup buckets ready condition",
+			seed:     testSeedNoSeedBackupBucketsReady,
+			expected: []seeker.RejectionReason{seeker.ReasonBackupNotReady},
+		},
+		{
+			name:     "backup buckets not ready",
+			seed:     testSeedSeedBackupBucketsReadyFalse,
+			expected: []seeker.RejectionReason{seeker.ReasonBackupNotReady},
+		},
+		{
+			name: "OK",
+			seed: testSeedOK,
+		},
+		{
+			name: "OK with backup",
+			seed: testSeedOKWithBackup,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// WHEN
+			actual := seeker.EvaluateSeed(&testCase.seed)
+
+			// THEN
+			require.Equal(t, testCase.expected, reasons(actual))
+			require.Equal(t, len(testCase.expected) == 0, actual.Usable())
+			require.Equal(t, testCase.seed.Spec.Provider.Type, actual.Provider)
+			require.Equal(t, testCase.seed.Spec.Provider.Region, actual.Region)
+		})
+	}
+}
+
+func TestVerdictsToConfigMap(t *testing.T) {
+	// WHEN
+	actual, err := seeker.VerdictsToConfigMap([]seeker.SeedVerdict{
+		{
+			Seed:     "test-seed1",
+			Provider: testProviderType1,
+			Region:   testRegion1,
+		},
+		{
+			Seed:     "test-seed2",
+			Provider: testProviderType2,
+			Region:   testRegion2,
+			Rejections: []seeker.Rejection{
+				{
+					Reason:  seeker.ReasonInvisible,
+					Message: "scheduling visibility disabled",
+				},
+			},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"test-seed1": `provider: test-provider-type1
+region: test-region1
+seed: test-seed1`,
+		"test-seed2": `provider: test-provider-type2
+region: test-region2
+rejections:
+- message: scheduling visibility disabled
+  reason: invisible
+seed: test-seed2`,
+	}, actual)
+}